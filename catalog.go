@@ -0,0 +1,243 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/xerrors"
+)
+
+// Code describes a known error, which is identified by a stable, machine
+// readable ID. Errors created from a [Code] with [Code.New] or [Code.Wrap]
+// expose the code's information via the [Coder], [StatusCoder], [ExitCoder]
+// interfaces, the latter two only when the code's StatusCode or ExitCode is
+// set, and are considered equal to the [Code] and its [Msg] when
+// comparing with [Is].
+//
+//	var ErrUserNotFound = errors.Define(errors.Code{
+//		ID:         "USR-001",
+//		Msg:        "user not found",
+//		StatusCode: http.StatusNotFound,
+//		Severity:   errors.SeverityWarning,
+//		DocsURL:    "https://example.com/errors/USR-001",
+//	})
+//
+//	err := ErrUserNotFound.New()
+//	errors.Is(err, ErrUserNotFound) // true
+type Code struct {
+	// ID is the stable, machine readable code of the error.
+	ID string
+	// Msg is the error message.
+	Msg Msg
+	// StatusCode is the default (http) status code of the error.
+	StatusCode int
+	// ExitCode is the default exit code of the error.
+	ExitCode int
+	// Severity of the error.
+	Severity Severity
+	// DocsURL points to the documentation of the error.
+	DocsURL string
+}
+
+// New creates a new error from the [Code], which records a stack trace at the
+// point it was called.
+func (c *Code) New() error {
+	err := newCodeErr(newCommonErr(c.Msg, true, 1), c)
+	runHooks(err, 1)
+	return err
+}
+
+// Wrap creates a new error from the [Code] that wraps around the causing
+// error, similar to [Wrap]. It returns nil when cause is nil.
+func (c *Code) Wrap(cause error) error {
+	if cause == nil {
+		return nil
+	}
+	err := newCodeErr(newWrapErr(c.Msg, cause, 1), c)
+	runHooks(err, 1)
+	return err
+}
+
+// Error returns the error message of the [Code].
+func (c *Code) Error() string { return string(c.Msg) }
+
+// GoString prints the [Code] in basic Go syntax.
+func (c *Code) GoString() string {
+	return fmt.Sprintf("errors.Code{ID: %q, Msg: %q}", c.ID, string(c.Msg))
+}
+
+// A Coder provides access to the [Code] an error is created from.
+type Coder interface {
+	error
+	Code() *Code
+}
+
+// GetCode returns the [Code] of the first found [Coder] in err's error chain,
+// or nil if none is found.
+func GetCode(err error) *Code {
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if e, ok := err.(Coder); ok {
+			return e.Code()
+		}
+		err = Unwrap(err)
+	}
+	return nil
+}
+
+const (
+	panicDefineEmptyID   = "errors.Define: code must have a non-empty ID"
+	panicDefineDuplicate = "errors.Define: code with ID `%s` is already defined"
+)
+
+// Catalog is a collection of uniquely identified [Code]s. Its zero value is
+// ready to use.
+type Catalog struct {
+	mut   sync.RWMutex
+	codes map[string]*Code
+}
+
+// DefaultCatalog is the [Catalog] codes are added to when using [Define].
+var DefaultCatalog = new(Catalog)
+
+// Define adds code to [DefaultCatalog] and returns a pointer to the added
+// [Code]. See [Catalog.Define] for additional details.
+func Define(code Code) *Code { return DefaultCatalog.Define(code) }
+
+// Define adds code to the [Catalog] and returns a pointer to the added [Code].
+// It panics when the code's ID is empty or already defined within the
+// [Catalog].
+func (c *Catalog) Define(code Code) *Code {
+	if code.ID == "" {
+		panic(panicDefineEmptyID)
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if _, exists := c.codes[code.ID]; exists {
+		panic(fmt.Sprintf(panicDefineDuplicate, code.ID))
+	}
+	if c.codes == nil {
+		c.codes = make(map[string]*Code, 8)
+	}
+
+	ptr := &code
+	c.codes[code.ID] = ptr
+	return ptr
+}
+
+// Lookup returns the [Code] with the provided id, if it is defined within the
+// [Catalog].
+func (c *Catalog) Lookup(id string) (*Code, bool) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	code, ok := c.codes[id]
+	return code, ok
+}
+
+// Codes returns all defined [Code]s within the [Catalog], sorted by their ID.
+func (c *Catalog) Codes() []*Code {
+	c.mut.RLock()
+	res := make([]*Code, 0, len(c.codes))
+	for _, code := range c.codes {
+		res = append(res, code)
+	}
+	c.mut.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+type codeError struct {
+	*commonError
+	code *Code
+}
+
+// newCodeErr creates a new [codeError] from ce and code. The error only
+// implements [StatusCoder] and/or [ExitCoder] when the code's StatusCode
+// and/or ExitCode are set, so codes of the error's cause, or the default
+// value of [GetStatusCodeOr] and [GetExitCodeOr], are not hidden.
+func newCodeErr(ce *commonError, code *Code) error {
+	e := &codeError{commonError: ce, code: code}
+	switch {
+	case code.StatusCode != 0 && code.ExitCode != 0:
+		return &codeStatusExitError{e}
+	case code.StatusCode != 0:
+		return &codeStatusError{e}
+	case code.ExitCode != 0:
+		return &codeExitError{e}
+	default:
+		return e
+	}
+}
+
+func (e *codeError) codeErr() *codeError { return e }
+func (e *codeError) Code() *Code         { return e.code }
+func (e *codeError) Severity() Severity  { return e.code.Severity }
+func (e *codeError) DocsURL() string     { return e.code.DocsURL }
+
+type codeStatusError struct{ *codeError }
+
+func (e *codeStatusError) StatusCode() int { return e.code.StatusCode }
+
+type codeExitError struct{ *codeError }
+
+func (e *codeExitError) ExitCode() int { return e.code.ExitCode }
+
+type codeStatusExitError struct{ *codeError }
+
+func (e *codeStatusExitError) StatusCode() int { return e.code.StatusCode }
+func (e *codeStatusExitError) ExitCode() int   { return e.code.ExitCode }
+
+// codeErrorer is implemented by [codeError] and the errors that embed it.
+type codeErrorer interface {
+	error
+	codeErr() *codeError
+}
+
+func (e *codeError) Is(target error) bool {
+	//goland:noinspection GoTypeAssertionOnErrors
+	if c, ok := target.(*Code); ok {
+		return e.code == c
+	}
+	return e.commonError.Is(target)
+}
+
+// Format uses [xerrors.FormatError] to call the [FormatError] method of the
+// error with a [Printer] configured according to s and v, and writes the
+// result to s.
+func (e *codeError) Format(s fmt.State, v rune) {
+	xerrors.FormatError(e, s, v)
+}
+
+// FormatError prints the error to the [Printer], including the code's ID,
+// documentation URL and stack trace when details are requested, and returns
+// the next error in the error chain, if any.
+func (e *codeError) FormatError(p Printer) error {
+	p.Print(e.Error())
+	if !p.Detail() {
+		return formatNext(e.cause, e.stack)
+	}
+
+	p.Printf("code: %s\n", e.code.ID)
+	if e.code.DocsURL != "" {
+		p.Printf("docs: %s\n", e.code.DocsURL)
+	}
+	e.stack.Format(p)
+	return formatNext(e.cause, e.stack)
+}
+
+// GoString prints the error in basic Go syntax.
+func (e *codeError) GoString() string {
+	return fmt.Sprintf(
+		"errors.codeError{code: %#v, commonError: %#v}",
+		e.code,
+		e.commonError,
+	)
+}
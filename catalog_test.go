@@ -0,0 +1,113 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-pogo/errors/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog_Define(t *testing.T) {
+	t.Run("empty id", func(t *testing.T) {
+		var cat Catalog
+		assert.PanicsWithValue(t, panicDefineEmptyID, func() {
+			cat.Define(Code{Msg: "some err"})
+		})
+	})
+	t.Run("duplicate", func(t *testing.T) {
+		var cat Catalog
+		cat.Define(Code{ID: "E1"})
+		assert.PanicsWithValue(t, fmt.Sprintf(panicDefineDuplicate, "E1"), func() {
+			cat.Define(Code{ID: "E1"})
+		})
+	})
+	t.Run("lookup", func(t *testing.T) {
+		var cat Catalog
+		want := cat.Define(Code{ID: "E2", Msg: "second"})
+		cat.Define(Code{ID: "E1", Msg: "first"})
+
+		have, ok := cat.Lookup("E2")
+		assert.True(t, ok)
+		assert.Same(t, want, have)
+
+		_, ok = cat.Lookup("E3")
+		assert.False(t, ok)
+
+		codes := cat.Codes()
+		assert.Len(t, codes, 2)
+		assert.Equal(t, "E1", codes[0].ID)
+		assert.Same(t, want, codes[1])
+	})
+}
+
+func TestCode(t *testing.T) {
+	var cat Catalog
+	code := cat.Define(Code{
+		ID:         "USR-001",
+		Msg:        "user not found",
+		StatusCode: http.StatusNotFound,
+		ExitCode:   2,
+		Severity:   SeverityWarning,
+		DocsURL:    "https://example.com/errors/USR-001",
+	})
+	other := cat.Define(Code{ID: "USR-002", Msg: "user not found"})
+
+	cause := stderrors.New("cause")
+	tests := map[string]error{
+		"New":  code.New(),
+		"Wrap": code.Wrap(cause),
+	}
+
+	for name, err := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, "user not found", err.Error())
+			assert.ErrorIs(t, err, code)
+			assert.ErrorIs(t, err, Msg("user not found"))
+			assert.NotErrorIs(t, err, other)
+
+			assert.Same(t, code, GetCode(err))
+			assert.Same(t, code, GetCode(Wrap(err, "wrapped")))
+			assert.Equal(t, http.StatusNotFound, GetStatusCode(err))
+			assert.Equal(t, 2, GetExitCode(err))
+
+			detail := fmt.Sprintf("%+v", err)
+			assert.Contains(t, detail, "code: USR-001")
+			assert.Contains(t, detail, "docs: https://example.com/errors/USR-001")
+			if internal.TraceStack {
+				assert.NotNil(t, GetStackTrace(err))
+			}
+		})
+	}
+
+	t.Run("Wrap nil", func(t *testing.T) {
+		assert.Nil(t, code.Wrap(nil))
+	})
+	t.Run("Wrap cause", func(t *testing.T) {
+		err := code.Wrap(cause)
+		assert.ErrorIs(t, err, cause)
+		assert.Equal(t, "user not found: cause", fmt.Sprintf("%v", err))
+	})
+	t.Run("unset codes", func(t *testing.T) {
+		err := other.Wrap(WithExitCode(WithStatusCode(cause, http.StatusConflict), 3))
+		assert.Equal(t, http.StatusConflict, GetStatusCode(err))
+		assert.Equal(t, 3, GetExitCode(err))
+
+		assert.Equal(t, http.StatusInternalServerError, GetStatusCodeOr(other.New(), http.StatusInternalServerError))
+		assert.Equal(t, 1, GetExitCodeOr(other.New(), 1))
+	})
+	t.Run("explicit zero override", func(t *testing.T) {
+		err := WithExitCode(code.New(), 0)
+		assert.Equal(t, 0, GetExitCodeOr(err, 1))
+	})
+	t.Run("GetCode without code", func(t *testing.T) {
+		assert.Nil(t, GetCode(cause))
+		assert.Nil(t, GetCode(nil))
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"io"
+	"sync"
+)
+
+const (
+	panicCloseIntoNilPtr     = "errors.CloseInto: dest must not be a nil pointer"
+	panicCloseIntoNilCloser  = "errors.CloseInto: closer must not be nil"
+	panicCloseFuncNilPtr     = "errors.CloseFunc: dest must not be a nil pointer"
+	panicCloseFuncNilFn      = "errors.CloseFunc: fn must not be nil"
+	panicCloseFuncWrapNilPtr = "errors.CloseFuncWrap: dest must not be a nil pointer"
+	panicCloseFuncWrapNilFn  = "errors.CloseFuncWrap: fn must not be nil"
+	panicCleanupNilFn        = "errors.Cleanup: fn must not be nil"
+	panicCleanupNilCloser    = "errors.Cleanup: closer must not be nil"
+	panicCleanupNilPtr       = "errors.Cleanup: dest must not be a nil pointer"
+)
+
+// CloseInto closes c and appends its error, if any, to dest using
+// [AppendInto]. Use it with defer to not silently drop the error returned by
+// Close.
+//
+//	func readConfig(name string) (_ *Config, err error) {
+//		f, err := os.Open(name)
+//		if err != nil {
+//			return nil, err
+//		}
+//		defer errors.CloseInto(&err, f)
+//		// ...
+//	}
+//
+// Important: the pointer to the dest error must be a named return variable,
+// see [AppendInto].
+func CloseInto(dest *error, c io.Closer) {
+	if dest == nil {
+		panic(panicCloseIntoNilPtr)
+	}
+	if c == nil {
+		panic(panicCloseIntoNilCloser)
+	}
+	AppendInto(dest, c.Close())
+}
+
+// CloseFunc calls fn and appends its error, if any, to dest using
+// [AppendInto]. It is similar to [CloseInto] but accepts a function.
+//
+//	defer errors.CloseFunc(&err, tx.Rollback)
+func CloseFunc(dest *error, fn func() error) {
+	if dest == nil {
+		panic(panicCloseFuncNilPtr)
+	}
+	if fn == nil {
+		panic(panicCloseFuncNilFn)
+	}
+	AppendInto(dest, fn())
+}
+
+// CloseFuncWrap is like [CloseFunc] but wraps the error of fn with msg, like
+// [Wrap], before it is appended to dest. Argument msg can be either a string
+// or [Msg].
+//
+//	defer errors.CloseFuncWrap(&err, conn.Close, "close connection")
+func CloseFuncWrap(dest *error, fn func() error, msg interface{}) {
+	if dest == nil {
+		panic(panicCloseFuncWrapNilPtr)
+	}
+	if fn == nil {
+		panic(panicCloseFuncWrapNilFn)
+	}
+
+	cause := fn()
+	if cause == nil {
+		return
+	}
+	if msg == nil {
+		AppendInto(dest, cause)
+		return
+	}
+
+	err := newWrapErr(toMsg("errors.CloseFuncWrap", msg), cause, 1)
+	runHooks(err, 1)
+	AppendInto(dest, err)
+}
+
+// Cleanup is a stack of cleanup functions, which are run in reverse order of
+// registration, like deferred functions. Errors returned by the functions, and
+// panics that occur while running them, are joined into a single error using
+// [AppendInto]. The zero value is ready to use and Cleanup is safe for
+// concurrent use.
+//
+//	var cleanup errors.Cleanup
+//	defer cleanup.RunInto(&err)
+//
+//	db, err := sql.Open(driver, dsn)
+//	if err != nil {
+//		return err
+//	}
+//	cleanup.AddCloser(db)
+type Cleanup struct {
+	mut sync.Mutex
+	fns []func() error
+}
+
+// Add registers fn to be run by [Cleanup.Run].
+func (c *Cleanup) Add(fn func() error) {
+	if fn == nil {
+		panic(panicCleanupNilFn)
+	}
+
+	c.mut.Lock()
+	c.fns = append(c.fns, fn)
+	c.mut.Unlock()
+}
+
+// AddCloser registers the Close method of closer to be run by [Cleanup.Run].
+func (c *Cleanup) AddCloser(closer io.Closer) {
+	if closer == nil {
+		panic(panicCleanupNilCloser)
+	}
+	c.Add(closer.Close)
+}
+
+// Len returns the number of registered cleanup functions that are not run
+// yet.
+func (c *Cleanup) Len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return len(c.fns)
+}
+
+// Run runs all registered cleanup functions in reverse order of registration
+// and removes them from the [Cleanup]. A panic within a function is recovered
+// and added as error, see [CatchPanic], after which the remaining functions
+// are still run. It returns the joined errors, or nil when there are none.
+func (c *Cleanup) Run() error {
+	var err error
+	c.RunInto(&err)
+	return err
+}
+
+// RunInto runs the registered cleanup functions like [Cleanup.Run], and
+// appends the resulting errors to dest using [AppendInto]. It returns true
+// when any error is appended.
+func (c *Cleanup) RunInto(dest *error) (errored bool) {
+	if dest == nil {
+		panic(panicCleanupNilPtr)
+	}
+
+	c.mut.Lock()
+	fns := c.fns
+	c.fns = nil
+	c.mut.Unlock()
+
+	for i := len(fns) - 1; i >= 0; i-- {
+		if err := runCleanup(fns[i]); err != nil {
+			AppendInto(dest, err)
+			errored = true
+		}
+	}
+	return errored
+}
+
+func runCleanup(fn func() error) (err error) {
+	defer CatchPanic(&err)
+	return fn()
+}
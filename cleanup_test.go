@@ -0,0 +1,161 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type closerFunc func() error
+
+func (fn closerFunc) Close() error { return fn() }
+
+func TestCloseInto(t *testing.T) {
+	t.Run("panic on nil dest ptr", func(t *testing.T) {
+		assert.PanicsWithValue(t, panicCloseIntoNilPtr, func() {
+			CloseInto(nil, closerFunc(func() error { return nil }))
+		})
+	})
+	t.Run("panic on nil closer", func(t *testing.T) {
+		assert.PanicsWithValue(t, panicCloseIntoNilCloser, func() {
+			var err error
+			CloseInto(&err, nil)
+		})
+	})
+
+	closeErr := stderrors.New("close err")
+	fn := func(retErr error) (err error) {
+		defer CloseInto(&err, closerFunc(func() error { return closeErr }))
+		return retErr
+	}
+
+	t.Run("close error", func(t *testing.T) {
+		assert.Same(t, closeErr, fn(nil))
+	})
+	t.Run("both errors", func(t *testing.T) {
+		retErr := stderrors.New("ret err")
+		have := fn(retErr)
+		assert.ErrorIs(t, have, retErr)
+		assert.ErrorIs(t, have, closeErr)
+	})
+}
+
+func TestCloseFunc(t *testing.T) {
+	t.Run("panic on nil dest ptr", func(t *testing.T) {
+		assert.PanicsWithValue(t, panicCloseFuncNilPtr, func() {
+			CloseFunc(nil, func() error { return nil })
+		})
+		assert.PanicsWithValue(t, panicCloseFuncWrapNilPtr, func() {
+			CloseFuncWrap(nil, func() error { return nil }, "msg")
+		})
+	})
+	t.Run("panic on nil func", func(t *testing.T) {
+		var err error
+		assert.PanicsWithValue(t, panicCloseFuncNilFn, func() {
+			CloseFunc(&err, nil)
+		})
+		assert.PanicsWithValue(t, panicCloseFuncWrapNilFn, func() {
+			CloseFuncWrap(&err, nil, "msg")
+		})
+	})
+
+	closeErr := stderrors.New("close err")
+	tests := map[string]struct {
+		fn      func(dest *error)
+		wantMsg string
+	}{
+		"CloseFunc": {
+			fn:      func(dest *error) { CloseFunc(dest, func() error { return closeErr }) },
+			wantMsg: "close err",
+		},
+		"CloseFuncWrap": {
+			fn:      func(dest *error) { CloseFuncWrap(dest, func() error { return closeErr }, "close file") },
+			wantMsg: "close file",
+		},
+		"CloseFuncWrap without msg": {
+			fn:      func(dest *error) { CloseFuncWrap(dest, func() error { return closeErr }, nil) },
+			wantMsg: "close err",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var have error
+			tc.fn(&have)
+			assert.ErrorIs(t, have, closeErr)
+			assert.Equal(t, tc.wantMsg, have.Error())
+		})
+	}
+	t.Run("nil error", func(t *testing.T) {
+		var have error
+		CloseFunc(&have, func() error { return nil })
+		CloseFuncWrap(&have, func() error { return nil }, "msg")
+		assert.Nil(t, have)
+	})
+}
+
+func TestCleanup(t *testing.T) {
+	t.Run("panic on nil", func(t *testing.T) {
+		var c Cleanup
+		assert.PanicsWithValue(t, panicCleanupNilFn, func() { c.Add(nil) })
+		assert.PanicsWithValue(t, panicCleanupNilCloser, func() { c.AddCloser(nil) })
+		assert.PanicsWithValue(t, panicCleanupNilPtr, func() { c.RunInto(nil) })
+	})
+	t.Run("empty", func(t *testing.T) {
+		var c Cleanup
+		assert.Nil(t, c.Run())
+	})
+	t.Run("lifo", func(t *testing.T) {
+		var order []int
+		var c Cleanup
+		for i := 0; i < 3; i++ {
+			i := i
+			c.Add(func() error {
+				order = append(order, i)
+				return nil
+			})
+		}
+
+		assert.Equal(t, 3, c.Len())
+		assert.Nil(t, c.Run())
+		assert.Equal(t, []int{2, 1, 0}, order)
+		assert.Equal(t, 0, c.Len())
+
+		// functions are only run once
+		assert.Nil(t, c.Run())
+		assert.Len(t, order, 3)
+	})
+	t.Run("errors and panics", func(t *testing.T) {
+		err1, err2 := stderrors.New("err1"), stderrors.New("err2")
+
+		var c Cleanup
+		c.Add(func() error { return err1 })
+		c.Add(func() error { panic("oops") })
+		c.AddCloser(closerFunc(func() error { return err2 }))
+
+		have := c.Run()
+		assert.ErrorIs(t, have, err1)
+		assert.ErrorIs(t, have, err2)
+
+		errs := have.(MultiError).Unwrap()
+		assert.Len(t, errs, 3)
+		assert.Same(t, err2, errs[0])
+		assert.Equal(t, "panic: oops", errs[1].Error())
+		assert.Same(t, err1, errs[2])
+	})
+	t.Run("into", func(t *testing.T) {
+		retErr := stderrors.New("ret err")
+		fn := func() (err error) {
+			var c Cleanup
+			defer c.RunInto(&err)
+
+			c.Add(func() error { return nil })
+			return retErr
+		}
+		assert.Same(t, retErr, fn())
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command errdoc scans Go packages for error catalog declarations, created
+// with errors.Define or errors.Catalog.Define, and generates an error
+// reference in Markdown or JSON format.
+//
+// Usage:
+//
+//	errdoc [-format markdown|json] [-o file] [packages]
+//
+// Packages are provided as directories. A directory ending with "/..."
+// includes all of its subdirectories. When no packages are provided, the
+// current directory is scanned.
+//
+//	errdoc -format json -o errors.json ./...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "errdoc: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) (err error) {
+	fs := flag.NewFlagSet("errdoc", flag.ContinueOnError)
+	format := fs.String("format", "markdown", "output format, either markdown or json")
+	output := fs.String("o", "", "write output to file instead of stdout")
+	if err = fs.Parse(args); err != nil {
+		return err
+	}
+
+	var write func(io.Writer, []Entry) error
+	switch *format {
+	case "markdown", "md":
+		write = writeMarkdown
+	case "json":
+		write = writeJSON
+	default:
+		return fmt.Errorf("unsupported format `%s`", *format)
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	entries, err := scan(patterns)
+	if err != nil {
+		return err
+	}
+
+	w := stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		w = f
+	}
+	return write(w, entries)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var wantEntries = []Entry{
+	{
+		Package:  "example",
+		Name:     "ErrInvalidConfig",
+		ID:       "CFG-001",
+		Msg:      "invalid | config",
+		ExitCode: 78,
+		Severity: "critical",
+		Position: "testdata/example/example.go:22",
+	},
+	{
+		Package:    "example",
+		Name:       "ErrUserNotFound",
+		ID:         "USR-001",
+		Msg:        "user not found",
+		StatusCode: 404,
+		Severity:   "warning",
+		DocsURL:    "https://example.com/errors/USR-001",
+		Position:   "testdata/example/example.go:11",
+	},
+}
+
+func TestScan(t *testing.T) {
+	have, err := scan([]string{"testdata/example"})
+	assert.NoError(t, err)
+	assert.Equal(t, wantEntries, have)
+
+	t.Run("recursive", func(t *testing.T) {
+		have, err = scan([]string{"testdata/..."})
+		assert.NoError(t, err)
+		assert.Equal(t, wantEntries, have)
+	})
+	t.Run("skip testdata", func(t *testing.T) {
+		have, err = scan([]string{"./..."})
+		assert.NoError(t, err)
+		assert.Empty(t, have)
+	})
+	t.Run("not exists", func(t *testing.T) {
+		_, err = scan([]string{"testdata/does-not-exist"})
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPStatusCode(t *testing.T) {
+	tests := map[string]int{
+		"StatusOK":                   200,
+		"StatusNotFound":             404,
+		"StatusUnprocessableEntity":  422,
+		"StatusTeapot":               418,
+		"StatusNonAuthoritativeInfo": 203,
+		"StatusRequestURITooLong":    414,
+		"NotFound":                   0,
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, httpStatusCode(name), name)
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("markdown", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, run([]string{"testdata/example"}, &buf))
+		assert.Equal(t, "# Error reference\n\n"+
+			"| Code | Message | Status code | Exit code | Severity | Package |\n"+
+			"|------|---------|-------------|-----------|----------|---------|\n"+
+			"| `CFG-001` | invalid \\| config |  | 78 | critical | example |\n"+
+			"| [`USR-001`](https://example.com/errors/USR-001) | user not found | 404 |  | warning | example |\n",
+			buf.String(),
+		)
+	})
+	t.Run("json", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "errors.json")
+		assert.NoError(t, run([]string{"-format", "json", "-o", file, "testdata/example"}, nil))
+
+		data, err := os.ReadFile(file)
+		assert.NoError(t, err)
+
+		var have []Entry
+		assert.NoError(t, json.Unmarshal(data, &have))
+		assert.Equal(t, wantEntries, have)
+	})
+	t.Run("unsupported format", func(t *testing.T) {
+		assert.Error(t, run([]string{"-format", "xml"}, nil))
+	})
+}
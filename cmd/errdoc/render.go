@@ -0,0 +1,59 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func writeJSON(w io.Writer, entries []Entry) error {
+	if entries == nil {
+		entries = []Entry{}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+func writeMarkdown(w io.Writer, entries []Entry) error {
+	var b strings.Builder
+	b.WriteString("# Error reference\n\n")
+	b.WriteString("| Code | Message | Status code | Exit code | Severity | Package |\n")
+	b.WriteString("|------|---------|-------------|-----------|----------|---------|\n")
+
+	for _, e := range entries {
+		id := "`" + e.ID + "`"
+		if e.DocsURL != "" {
+			id = "[" + id + "](" + e.DocsURL + ")"
+		}
+
+		_, _ = fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+			id,
+			escapeMarkdown(e.Msg),
+			optionalInt(e.StatusCode),
+			optionalInt(e.ExitCode),
+			e.Severity,
+			e.Package,
+		)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func optionalInt(i int) string {
+	if i == 0 {
+		return ""
+	}
+	return fmt.Sprint(i)
+}
+
+var markdownReplacer = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func escapeMarkdown(s string) string { return markdownReplacer.Replace(s) }
@@ -0,0 +1,354 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Entry is a single error catalog declaration found in the scanned source
+// code.
+type Entry struct {
+	Package    string `json:"package"`
+	Name       string `json:"name,omitempty"`
+	ID         string `json:"id"`
+	Msg        string `json:"message"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	ExitCode   int    `json:"exitCode,omitempty"`
+	Severity   string `json:"severity,omitempty"`
+	DocsURL    string `json:"docsURL,omitempty"`
+	Position   string `json:"position"`
+}
+
+// scan parses the packages within the directories matching patterns and
+// returns all found catalog entries, sorted by their ID.
+func scan(patterns []string) ([]Entry, error) {
+	var dirs []string
+	for _, pattern := range patterns {
+		if !strings.HasSuffix(pattern, "/...") {
+			dirs = append(dirs, pattern)
+			continue
+		}
+
+		root := strings.TrimSuffix(pattern, "/...")
+		if root == "" {
+			root = "."
+		}
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				return nil
+			}
+			if path != root {
+				name := d.Name()
+				if name == "testdata" || name == "vendor" ||
+					strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+					return filepath.SkipDir
+				}
+			}
+			dirs = append(dirs, path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res []Entry
+	for _, dir := range dirs {
+		entries, err := scanDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, entries...)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// scanDir parses all non-test Go files within dir and returns the found
+// catalog entries.
+func scanDir(dir string) ([]Entry, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+
+	s := scanner{fset: fset, consts: make(map[string]ast.Expr)}
+	for _, f := range parsed {
+		s.collectConsts(f)
+	}
+
+	var res []Entry
+	for _, f := range parsed {
+		res = append(res, s.entries(f)...)
+	}
+	return res, nil
+}
+
+type scanner struct {
+	fset *token.FileSet
+	// consts contains the values of all package level constants.
+	consts map[string]ast.Expr
+}
+
+func (s *scanner) collectConsts(f *ast.File) {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if i < len(vs.Values) {
+					s.consts[name.Name] = vs.Values[i]
+				}
+			}
+		}
+	}
+}
+
+func (s *scanner) entries(f *ast.File) []Entry {
+	var res []Entry
+	var name string
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.ValueSpec:
+			// remember the name of the variable a code might be assigned to
+			name = ""
+			if len(node.Names) == 1 {
+				name = node.Names[0].Name
+			}
+
+		case *ast.CallExpr:
+			lit := codeLiteral(node)
+			if lit == nil {
+				return true
+			}
+
+			e := s.entry(lit)
+			e.Package = f.Name.Name
+			e.Name = name
+			e.Position = s.position(node.Pos())
+			res = append(res, e)
+			return false
+		}
+		return true
+	})
+	return res
+}
+
+// codeLiteral returns the Code composite literal when call is a call to a
+// Define function or method, e.g. errors.Define(errors.Code{...}).
+func codeLiteral(call *ast.CallExpr) *ast.CompositeLit {
+	var fn string
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		fn = fun.Sel.Name
+	case *ast.Ident:
+		fn = fun.Name
+	}
+	if fn != "Define" || len(call.Args) != 1 {
+		return nil
+	}
+
+	lit, ok := call.Args[0].(*ast.CompositeLit)
+	if !ok || typeName(lit.Type) != "Code" {
+		return nil
+	}
+	return lit
+}
+
+func (s *scanner) entry(lit *ast.CompositeLit) Entry {
+	var e Entry
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "ID":
+			e.ID = s.stringValue(kv.Value)
+		case "Msg":
+			e.Msg = s.stringValue(kv.Value)
+		case "StatusCode":
+			e.StatusCode = s.intValue(kv.Value)
+		case "ExitCode":
+			e.ExitCode = s.intValue(kv.Value)
+		case "Severity":
+			e.Severity = strings.ToLower(strings.TrimPrefix(typeName(kv.Value), "Severity"))
+		case "DocsURL":
+			e.DocsURL = s.stringValue(kv.Value)
+		}
+	}
+	return e
+}
+
+func (s *scanner) stringValue(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.BasicLit:
+		if v.Kind == token.STRING {
+			str, _ := strconv.Unquote(v.Value)
+			return str
+		}
+	case *ast.Ident:
+		if c, ok := s.consts[v.Name]; ok {
+			return s.stringValue(c)
+		}
+	case *ast.CallExpr:
+		// type conversion, e.g. errors.Msg("some error")
+		if len(v.Args) == 1 {
+			return s.stringValue(v.Args[0])
+		}
+	case *ast.BinaryExpr:
+		if v.Op == token.ADD {
+			return s.stringValue(v.X) + s.stringValue(v.Y)
+		}
+	case *ast.ParenExpr:
+		return s.stringValue(v.X)
+	}
+	return ""
+}
+
+func (s *scanner) intValue(expr ast.Expr) int {
+	switch v := expr.(type) {
+	case *ast.BasicLit:
+		if v.Kind == token.INT {
+			i, _ := strconv.ParseInt(v.Value, 0, 64)
+			return int(i)
+		}
+	case *ast.Ident:
+		if c, ok := s.consts[v.Name]; ok {
+			return s.intValue(c)
+		}
+	case *ast.SelectorExpr:
+		return httpStatusCode(v.Sel.Name)
+	case *ast.ParenExpr:
+		return s.intValue(v.X)
+	}
+	return 0
+}
+
+func (s *scanner) position(pos token.Pos) string {
+	p := s.fset.Position(pos)
+	return filepath.ToSlash(p.Filename) + ":" + strconv.Itoa(p.Line)
+}
+
+// typeName returns the name of an identifier or the selected name of a
+// selector expression, e.g. "Code" for both Code and errors.Code.
+func typeName(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.SelectorExpr:
+		return v.Sel.Name
+	}
+	return ""
+}
+
+// httpStatusCodes contains the status codes of all net/http Status constants
+// by name.
+var httpStatusCodes = map[string]int{
+	"StatusContinue":                      http.StatusContinue,
+	"StatusSwitchingProtocols":            http.StatusSwitchingProtocols,
+	"StatusProcessing":                    http.StatusProcessing,
+	"StatusEarlyHints":                    http.StatusEarlyHints,
+	"StatusOK":                            http.StatusOK,
+	"StatusCreated":                       http.StatusCreated,
+	"StatusAccepted":                      http.StatusAccepted,
+	"StatusNonAuthoritativeInfo":          http.StatusNonAuthoritativeInfo,
+	"StatusNoContent":                     http.StatusNoContent,
+	"StatusResetContent":                  http.StatusResetContent,
+	"StatusPartialContent":                http.StatusPartialContent,
+	"StatusMultiStatus":                   http.StatusMultiStatus,
+	"StatusAlreadyReported":               http.StatusAlreadyReported,
+	"StatusIMUsed":                        http.StatusIMUsed,
+	"StatusMultipleChoices":               http.StatusMultipleChoices,
+	"StatusMovedPermanently":              http.StatusMovedPermanently,
+	"StatusFound":                         http.StatusFound,
+	"StatusSeeOther":                      http.StatusSeeOther,
+	"StatusNotModified":                   http.StatusNotModified,
+	"StatusUseProxy":                      http.StatusUseProxy,
+	"StatusTemporaryRedirect":             http.StatusTemporaryRedirect,
+	"StatusPermanentRedirect":             http.StatusPermanentRedirect,
+	"StatusBadRequest":                    http.StatusBadRequest,
+	"StatusUnauthorized":                  http.StatusUnauthorized,
+	"StatusPaymentRequired":               http.StatusPaymentRequired,
+	"StatusForbidden":                     http.StatusForbidden,
+	"StatusNotFound":                      http.StatusNotFound,
+	"StatusMethodNotAllowed":              http.StatusMethodNotAllowed,
+	"StatusNotAcceptable":                 http.StatusNotAcceptable,
+	"StatusProxyAuthRequired":             http.StatusProxyAuthRequired,
+	"StatusRequestTimeout":                http.StatusRequestTimeout,
+	"StatusConflict":                      http.StatusConflict,
+	"StatusGone":                          http.StatusGone,
+	"StatusLengthRequired":                http.StatusLengthRequired,
+	"StatusPreconditionFailed":            http.StatusPreconditionFailed,
+	"StatusRequestEntityTooLarge":         http.StatusRequestEntityTooLarge,
+	"StatusRequestURITooLong":             http.StatusRequestURITooLong,
+	"StatusUnsupportedMediaType":          http.StatusUnsupportedMediaType,
+	"StatusRequestedRangeNotSatisfiable":  http.StatusRequestedRangeNotSatisfiable,
+	"StatusExpectationFailed":             http.StatusExpectationFailed,
+	"StatusTeapot":                        http.StatusTeapot,
+	"StatusMisdirectedRequest":            http.StatusMisdirectedRequest,
+	"StatusUnprocessableEntity":           http.StatusUnprocessableEntity,
+	"StatusLocked":                        http.StatusLocked,
+	"StatusFailedDependency":              http.StatusFailedDependency,
+	"StatusTooEarly":                      http.StatusTooEarly,
+	"StatusUpgradeRequired":               http.StatusUpgradeRequired,
+	"StatusPreconditionRequired":          http.StatusPreconditionRequired,
+	"StatusTooManyRequests":               http.StatusTooManyRequests,
+	"StatusRequestHeaderFieldsTooLarge":   http.StatusRequestHeaderFieldsTooLarge,
+	"StatusUnavailableForLegalReasons":    http.StatusUnavailableForLegalReasons,
+	"StatusInternalServerError":           http.StatusInternalServerError,
+	"StatusNotImplemented":                http.StatusNotImplemented,
+	"StatusBadGateway":                    http.StatusBadGateway,
+	"StatusServiceUnavailable":            http.StatusServiceUnavailable,
+	"StatusGatewayTimeout":                http.StatusGatewayTimeout,
+	"StatusHTTPVersionNotSupported":       http.StatusHTTPVersionNotSupported,
+	"StatusVariantAlsoNegotiates":         http.StatusVariantAlsoNegotiates,
+	"StatusInsufficientStorage":           http.StatusInsufficientStorage,
+	"StatusLoopDetected":                  http.StatusLoopDetected,
+	"StatusNotExtended":                   http.StatusNotExtended,
+	"StatusNetworkAuthenticationRequired": http.StatusNetworkAuthenticationRequired,
+}
+
+// httpStatusCode returns the status code of a net/http Status constant name,
+// e.g. 404 for StatusNotFound. It returns 0 when the name is unknown.
+func httpStatusCode(name string) int { return httpStatusCodes[name] }
@@ -0,0 +1,28 @@
+package example
+
+import (
+	"net/http"
+
+	"github.com/go-pogo/errors"
+)
+
+const ErrNotFoundMsg errors.Msg = "user not found"
+
+var ErrUserNotFound = errors.Define(errors.Code{
+	ID:         "USR-001",
+	Msg:        ErrNotFoundMsg,
+	StatusCode: http.StatusNotFound,
+	Severity:   errors.SeverityWarning,
+	DocsURL:    "https://example.com/errors/USR-001",
+})
+
+var catalog errors.Catalog
+
+var (
+	ErrInvalidConfig = catalog.Define(errors.Code{
+		ID:       "CFG-001",
+		Msg:      errors.Msg("invalid | config"),
+		ExitCode: 78,
+		Severity: errors.SeverityCritical,
+	})
+)
@@ -0,0 +1,222 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-pogo/errors/internal"
+	"golang.org/x/xerrors"
+)
+
+// Fields contain additional key/value information about an error.
+type Fields map[string]interface{}
+
+// Fielder interfaces provide access to [Fields] that are added to the error.
+type Fielder interface {
+	error
+	Fields() Fields
+}
+
+// GetFields returns the merged [Fields] of all [Fielder] errors in err's error
+// chain. Fields of errors closer to the start of the chain take precedence.
+// It returns nil when no fields are found.
+func GetFields(err error) Fields {
+	var res Fields
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if f, ok := err.(Fielder); ok {
+			for k, v := range f.Fields() {
+				if res == nil {
+					res = make(Fields, 4)
+				}
+				if _, exists := res[k]; !exists {
+					res[k] = v
+				}
+			}
+		}
+		err = Unwrap(err)
+	}
+	return res
+}
+
+// ContextExtractor extracts a value from a [context.Context]. It returns false
+// when ctx does not contain the value.
+type ContextExtractor func(ctx context.Context) (interface{}, bool)
+
+var extractors = struct {
+	sync.RWMutex
+	fns map[string]ContextExtractor
+}{}
+
+const panicRegisterNilExtractor = "errors.RegisterContextExtractor: extractor must not be nil"
+
+// RegisterContextExtractor registers a [ContextExtractor] which is used by
+// [WithContext] to copy a value from a [context.Context] into the field with
+// name key. An existing extractor with the same key is replaced.
+//
+//	errors.RegisterContextExtractor("request_id", func(ctx context.Context) (interface{}, bool) {
+//		id, ok := ctx.Value(requestIDKey{}).(string)
+//		return id, ok
+//	})
+func RegisterContextExtractor(key string, fn ContextExtractor) {
+	if fn == nil {
+		panic(panicRegisterNilExtractor)
+	}
+
+	extractors.Lock()
+	defer extractors.Unlock()
+
+	if extractors.fns == nil {
+		extractors.fns = make(map[string]ContextExtractor, 4)
+	}
+	extractors.fns[key] = fn
+}
+
+// UnregisterContextExtractor removes the [ContextExtractor] registered with
+// key.
+func UnregisterContextExtractor(key string) {
+	extractors.Lock()
+	delete(extractors.fns, key)
+	extractors.Unlock()
+}
+
+func extractFields(ctx context.Context) Fields {
+	if ctx == nil {
+		return nil
+	}
+
+	extractors.RLock()
+	defer extractors.RUnlock()
+
+	var res Fields
+	for key, fn := range extractors.fns {
+		if v, ok := fn(ctx); ok {
+			if res == nil {
+				res = make(Fields, len(extractors.fns))
+			}
+			res[key] = v
+		}
+	}
+	return res
+}
+
+// WithContext adds [Fields] to the error, of which the values are copied from
+// ctx using the extractors that are registered with
+// [RegisterContextExtractor]. It returns nil when err is nil.
+func WithContext(err error, ctx context.Context) Fielder {
+	if err == nil {
+		return nil
+	}
+
+	return &fieldsError{
+		embedError: &embedError{error: err},
+		fields:     extractFields(ctx),
+	}
+}
+
+// ContextCause returns the cause of ctx being canceled, as returned by
+// [context.Cause], with a stack trace and the time at which ContextCause was
+// called, and the [Fields] extracted from ctx, see [WithContext]. It returns
+// nil when ctx is not done.
+//
+//	select {
+//	case <-ctx.Done():
+//		return errors.ContextCause(ctx)
+//	case res := <-results:
+//		// ...
+//	}
+func ContextCause(ctx context.Context) error {
+	cause := context.Cause(ctx)
+	if cause == nil {
+		return nil
+	}
+
+	ee := &embedError{error: cause}
+	if internal.TraceStack {
+		ee.stack = newStackTrace(1, nil)
+	}
+	return &contextCauseError{
+		fieldsError: &fieldsError{
+			embedError: ee,
+			fields:     extractFields(ctx),
+		},
+		time: time.Now(),
+	}
+}
+
+type fieldsError struct {
+	*embedError
+	fields Fields
+}
+
+func (e *fieldsError) Fields() Fields { return e.fields }
+
+// Format uses [xerrors.FormatError] to call the [FormatError] method of the
+// error with a [Printer] configured according to s and v, and writes the
+// result to s.
+func (e *fieldsError) Format(s fmt.State, v rune) {
+	xerrors.FormatError(e, s, v)
+}
+
+// FormatError prints the error to the [Printer], including its [Fields] and
+// stack trace when details are requested, and returns the next error in the
+// error chain, if any.
+func (e *fieldsError) FormatError(p Printer) error {
+	msg := e.Error()
+	p.Print(msg)
+	if p.Detail() {
+		printUnredacted(p, e, msg)
+		e.fields.Format(p)
+		if stack := e.StackTrace(); stack != nil {
+			stack.Format(p)
+		}
+	}
+	return formatNext(Unwrap(Unembed(e.error)), e.StackTrace())
+}
+
+// GoString prints the error in basic Go syntax.
+func (e *fieldsError) GoString() string {
+	return fmt.Sprintf(
+		"errors.fieldsError{fields: %#v, embedErr: %#v}",
+		e.fields,
+		e.error,
+	)
+}
+
+type contextCauseError struct {
+	*fieldsError
+	time time.Time
+}
+
+func (e *contextCauseError) SetTime(t time.Time) { e.time = t }
+func (e *contextCauseError) Time() time.Time     { return e.time }
+
+// GoString prints the error in basic Go syntax.
+func (e *contextCauseError) GoString() string {
+	return fmt.Sprintf(
+		"errors.contextCauseError{time: %s, fieldsErr: %#v}",
+		e.time.String(),
+		e.fieldsError,
+	)
+}
+
+// Format prints the [Fields], sorted by key, as detail lines to the
+// [Printer].
+func (f Fields) Format(p Printer) {
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p.Printf("%s: %v\n", k, f[k])
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-pogo/errors/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+type requestIDKey struct{}
+
+func registerRequestID(t *testing.T) {
+	RegisterContextExtractor("request_id", func(ctx context.Context) (interface{}, bool) {
+		id, ok := ctx.Value(requestIDKey{}).(string)
+		return id, ok
+	})
+	t.Cleanup(func() { UnregisterContextExtractor("request_id") })
+}
+
+func TestRegisterContextExtractor(t *testing.T) {
+	assert.PanicsWithValue(t, panicRegisterNilExtractor, func() {
+		RegisterContextExtractor("nil", nil)
+	})
+}
+
+func TestWithContext(t *testing.T) {
+	registerRequestID(t)
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, WithContext(nil, context.Background()))
+	})
+	t.Run("without values", func(t *testing.T) {
+		err := WithContext(New("err"), context.Background())
+		assert.Nil(t, err.Fields())
+		assert.Nil(t, GetFields(err))
+	})
+	t.Run("nil context", func(t *testing.T) {
+		assert.Nil(t, WithContext(New("err"), nil).Fields())
+	})
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "abc123")
+	cause := stderrors.New("cause")
+	err := WithContext(Wrap(cause, "wrap"), ctx)
+
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, Fields{"request_id": "abc123"}, err.Fields())
+	assert.Equal(t, Fields{"request_id": "abc123"}, GetFields(Wrap(err, "outer")))
+	assert.Equal(t, "wrap: cause", fmt.Sprintf("%v", err))
+	assert.Contains(t, fmt.Sprintf("%+v", err), "request_id: abc123\n")
+}
+
+func TestGetFields(t *testing.T) {
+	registerRequestID(t)
+
+	inner := WithContext(New("err"), context.WithValue(context.Background(), requestIDKey{}, "inner"))
+	outer := WithContext(inner, context.WithValue(context.Background(), requestIDKey{}, "outer"))
+
+	assert.Nil(t, GetFields(nil))
+	assert.Equal(t, Fields{"request_id": "outer"}, GetFields(outer))
+}
+
+func TestContextCause(t *testing.T) {
+	registerRequestID(t)
+
+	t.Run("not done", func(t *testing.T) {
+		assert.Nil(t, ContextCause(context.Background()))
+	})
+
+	cause := stderrors.New("shutdown")
+	ctx, cancel := context.WithCancelCause(context.WithValue(context.Background(), requestIDKey{}, "abc123"))
+	cancel(cause)
+
+	before := time.Now()
+	err := ContextCause(ctx)
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "shutdown", err.Error())
+	assert.Equal(t, Fields{"request_id": "abc123"}, GetFields(err))
+
+	when, ok := GetTime(err)
+	assert.True(t, ok)
+	assert.False(t, when.Before(before))
+
+	if internal.TraceStack {
+		assert.NotNil(t, GetStackTrace(err))
+		assert.Contains(t, fmt.Sprintf("%+v", err), "context_test.go:")
+	}
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, ContextCause(ctx), context.Canceled)
+	})
+}
@@ -22,6 +22,23 @@ them as constants using errors.Msg.
 
 	const ErrSomethingWentWrong errors.Msg = "something went wrong"
 
+# Error catalog
+
+Known errors can be declared with a stable, machine readable code using
+errors.Define. Errors created from such an errors.Code expose its status code,
+exit code, severity and documentation URL.
+
+	var ErrUserNotFound = errors.Define(errors.Code{
+		ID:         "USR-001",
+		Msg:        "user not found",
+		StatusCode: http.StatusNotFound,
+	})
+
+	err := ErrUserNotFound.New()
+
+The errdoc command, located in cmd/errdoc, scans packages for these
+declarations and generates a Markdown or JSON error reference.
+
 # Formatting
 
 Wrap an existing error with errors.WithFormatter to upgrade the error to
@@ -56,6 +73,26 @@ the "notrace" tag.
 
 	go build -tags=notrace
 
+Stack tracing can also be controlled at runtime. Use errors.DisableStackTrace
+and errors.EnableStackTrace to toggle capturing, or errors.SetStackTraceOptions
+to limit the depth of captured stack traces, or to only capture them for
+errors created from within specific packages or for a sample of errors.
+
+	errors.SetStackTraceOptions(errors.StackTraceOptions{
+		MaxDepth:   32,
+		Packages:   []string{"github.com/my/app"},
+		SampleRate: 0.1,
+	})
+
+# Redacting sensitive values
+
+Arguments of errors.Errorf and errors.Wrapf that contain sensitive user data
+can be marked with errors.Redact. The error's message then contains a
+placeholder instead of the actual value, while the full message remains
+available via errors.Unredacted.
+
+	err := errors.Errorf("invalid email %s", errors.Redact(email))
+
 # Catching panics
 
 A convenient function is available to catch panics and store them as an error.
@@ -63,6 +100,29 @@ A convenient function is available to catch panics and store them as an error.
 	var err error
 	defer errors.CatchPanic(&err)
 
+Use errors.WrapPanicErr to add context to a panic, while keeping the original
+panic value and its stack trace, before it is recovered by errors.CatchPanic.
+
+	defer errors.WrapPanicErr("something went wrong")
+
+# Runtime information
+
+Use errors.NewCtx or errors.WrapCtx to also capture the id of the current
+goroutine, the pprof labels from the context and the binary's build
+information. This information is available via errors.GetRuntimeInfo and is
+printed when formatting the error with %+v.
+
+	err := errors.NewCtx(ctx, "user not found")
+
+# Context
+
+Use errors.WithContext to copy values from a context, such as a request or
+trace id, into the error's fields. Values are copied using extractors that are
+registered with errors.RegisterContextExtractor. errors.ContextCause turns the
+cause of a canceled context into an error with a stack trace and time.
+
+	err = errors.WithContext(err, ctx)
+
 # Backwards compatibility
 
 Unwrap, Is, As are backwards compatible with the standard library's errors
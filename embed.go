@@ -36,7 +36,7 @@ type embedError struct {
 // the first non-nil [StackTrace] of an embedded error.
 func (e *embedError) StackTrace() *StackTrace {
 	if e.stack == nil {
-		e.stack = GetStackTrace(Unembed(e.error))
+		return GetStackTrace(Unembed(e.error))
 	}
 	return e.stack
 }
@@ -58,7 +58,7 @@ func (e *embedError) Format(s fmt.State, v rune) {
 // the next error in the error chain, if any.
 func (e *embedError) FormatError(p Printer) error {
 	PrintError(p, e)
-	return Unwrap(Unembed(e.error))
+	return formatNext(Unwrap(Unembed(e.error)), e.StackTrace())
 }
 
 // GoString prints the error in basic Go syntax.
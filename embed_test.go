@@ -49,6 +49,9 @@ func provideEmbedders() map[string]func(parent error) error {
 		"WithTime": func(parent error) error {
 			return WithTime(parent, time.Now())
 		},
+		"WithSeverity": func(parent error) error {
+			return WithSeverity(parent, SeverityWarning)
+		},
 	}
 }
 
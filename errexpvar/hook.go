@@ -0,0 +1,70 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package errexpvar contains an [errors.Hook] which exposes the number of
+// created errors as [expvar] counters.
+//
+// It is a separate package because importing package expvar registers an
+// http handler on [http.DefaultServeMux].
+package errexpvar
+
+import (
+	"expvar"
+	"strings"
+
+	"github.com/go-pogo/errors"
+)
+
+// Counters contains the counters of created errors, grouped by message and by
+// the package of the caller which created the error.
+type Counters struct {
+	// Messages counts created errors per [errors.MessageKey].
+	Messages *expvar.Map
+	// Packages counts created errors per calling package.
+	Packages *expvar.Map
+}
+
+// NewHook publishes an [expvar.Map] with name, which contains the "messages"
+// and "packages" counters, and returns an [errors.Hook] that updates these
+// counters. Like [expvar.Publish], it panics when name is already in use.
+//
+//	errors.RegisterHook(errexpvar.NewHook("errors"))
+func NewHook(name string) errors.Hook {
+	c := NewCounters()
+	m := expvar.NewMap(name)
+	m.Set("messages", c.Messages)
+	m.Set("packages", c.Packages)
+	return c.Hook
+}
+
+// NewCounters creates new, unpublished [Counters].
+func NewCounters() *Counters {
+	return &Counters{
+		Messages: new(expvar.Map).Init(),
+		Packages: new(expvar.Map).Init(),
+	}
+}
+
+// Hook increments the counters for err and caller. It can be registered using
+// [errors.RegisterHook].
+func (c *Counters) Hook(err error, caller errors.Frame) {
+	c.Messages.Add(errors.MessageKey(err), 1)
+	c.Packages.Add(callerPackage(caller), 1)
+}
+
+// callerPackage returns the package path of the function of caller.
+func callerPackage(caller errors.Frame) string {
+	fn := caller.Func()
+	if fn == nil {
+		return "unknown"
+	}
+
+	// function names look like "github.com/go-pogo/errors.Func.func1"
+	name := fn.Name()
+	slash := strings.LastIndexByte(name, '/')
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot >= 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
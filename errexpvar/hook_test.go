@@ -0,0 +1,35 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errexpvar
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHook(t *testing.T) {
+	unregister := errors.RegisterHook(NewHook("errexpvar_test"))
+	defer unregister()
+
+	for i := 0; i < 3; i++ {
+		_ = errors.Errorf("user %d not found", i)
+	}
+	_ = errors.New("some err")
+
+	m := expvar.Get("errexpvar_test").(*expvar.Map)
+	msgs := m.Get("messages").(*expvar.Map)
+	assert.Equal(t, "3", msgs.Get("user %d not found").String())
+	assert.Equal(t, "1", msgs.Get("some err").String())
+
+	pkgs := m.Get("packages").(*expvar.Map)
+	assert.Equal(t, "4", pkgs.Get("github.com/go-pogo/errors/errexpvar").String())
+}
+
+func TestCallerPackage(t *testing.T) {
+	assert.Equal(t, "unknown", callerPackage(errors.Frame(0)))
+}
@@ -25,6 +25,8 @@ type Group struct {
 	cancel func(error)
 	wg     sync.WaitGroup
 	errs   errlist.List
+	// coll collects the errors instead of errs when set, see SetCollector.
+	coll errlist.Collector
 }
 
 // WithContext returns a new [Group] and an associated [context.Context] derived
@@ -38,16 +40,44 @@ func WithContext(ctx context.Context) (*Group, context.Context) {
 	return &Group{cancel: cancel}, ctx
 }
 
+// SetCollector sets the [errlist.Collector] which collects the errors from the
+// called functions passed to [Group.Go], instead of the [Group]'s own
+// [errlist.List]. Use an [errlist.ShardedList] when many functions return
+// errors concurrently. SetCollector must be called before the first call to
+// [Group.Go].
+//
+//	g, ctx := errgroup.WithContext(ctx)
+//	g.SetCollector(errlist.NewSharded(0))
+func (g *Group) SetCollector(c errlist.Collector) { g.coll = c }
+
+// Collector returns the [errlist.Collector] of collected errors from the
+// called functions passed to [Group.Go].
+func (g *Group) Collector() errlist.Collector {
+	if g.coll != nil {
+		return g.coll
+	}
+	return &g.errs
+}
+
 // ErrorList returns an [errlist.List] of collected errors from the called
-// functions passed to [Group.Go].
-func (g *Group) ErrorList() *errlist.List { return &g.errs }
+// functions passed to [Group.Go]. It returns nil when the errors are collected
+// by an [errlist.Collector] that is not an [errlist.List], see
+// [Group.SetCollector].
+func (g *Group) ErrorList() *errlist.List {
+	if g.coll == nil {
+		return &g.errs
+	}
+
+	l, _ := g.coll.(*errlist.List)
+	return l
+}
 
 // Wait blocks until all function calls from the [Group.Go] method have
 // returned, then returns all collected errors as a (multi) error.
 func (g *Group) Wait() error {
 	g.wg.Wait()
 
-	err := g.errs.Join()
+	err := g.Collector().Join()
 	if g.cancel != nil {
 		g.cancel(err)
 	}
@@ -69,9 +99,9 @@ func (g *Group) Go(fn func() error) {
 				defer g.cancel(err)
 			}
 			if errors.IsCause(err) {
-				g.errs.AppendUnique(err)
+				g.Collector().AppendUnique(err)
 			} else {
-				g.errs.Append(err)
+				g.Collector().Append(err)
 			}
 		}
 	}()
@@ -107,3 +107,41 @@ func TestWaitGroup_ErrorList(t *testing.T) {
 		assert.Exactly(t, wantList, wg.ErrorList())
 	})
 }
+
+func TestGroup_SetCollector(t *testing.T) {
+	someErr := errors.New("some err")
+
+	t.Run("default", func(t *testing.T) {
+		var wg Group
+		assert.Same(t, wg.ErrorList(), wg.Collector())
+	})
+	t.Run("list", func(t *testing.T) {
+		list := errlist.New(nil)
+
+		var wg Group
+		wg.SetCollector(list)
+		wg.Go(func() error { return someErr })
+
+		assert.Same(t, someErr, wg.Wait())
+		assert.Same(t, list, wg.ErrorList())
+		assert.Equal(t, []error{someErr}, list.All())
+	})
+	t.Run("sharded", func(t *testing.T) {
+		list := errlist.NewSharded(0)
+
+		wg, ctx := WithContext(context.Background())
+		wg.SetCollector(list)
+		for i := 0; i < 10; i++ {
+			wg.Go(func() error { return someErr })
+			wg.Go(func() error { return errors.Wrap(someErr, "wrapped") })
+		}
+
+		err := wg.Wait()
+		assert.ErrorIs(t, err, someErr)
+		assert.ErrorIs(t, context.Cause(ctx), someErr)
+		assert.Nil(t, wg.ErrorList())
+		assert.Same(t, list, wg.Collector())
+		// someErr is a root cause, so it is only added once
+		assert.Equal(t, 11, list.Len())
+	})
+}
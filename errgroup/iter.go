@@ -0,0 +1,34 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package errgroup
+
+import "iter"
+
+// Errors returns an iterator over the errors returned by the functions passed
+// to [Group.Go]. Like [Group.Wait], ranging over the iterator blocks until all
+// function calls have returned and cancels the [Group]'s context, if it was
+// created by calling [WithContext], with the same cause. Unlike [Group.Wait],
+// the errors are not combined into a single (multi) error.
+//
+//	for err := range g.Errors() {
+//		log.Println(err)
+//	}
+func (g *Group) Errors() iter.Seq[error] {
+	return func(yield func(error) bool) {
+		g.wg.Wait()
+		c := g.Collector()
+		if g.cancel != nil {
+			g.cancel(c.Join())
+		}
+
+		for _, err := range c.All() {
+			if !yield(err) {
+				return
+			}
+		}
+	}
+}
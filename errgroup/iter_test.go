@@ -0,0 +1,43 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package errgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup_Errors(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+
+	wg, ctx := WithContext(context.Background())
+	wg.Go(func() error { return err1 })
+	wg.Go(func() error { return nil })
+	wg.Go(func() error { return err2 })
+
+	var have []error
+	for err := range wg.Errors() {
+		have = append(have, err)
+	}
+
+	assert.ElementsMatch(t, []error{err1, err2}, have)
+	assert.Error(t, ctx.Err())
+	assert.Contains(t, []error{err1, err2}, context.Cause(ctx))
+
+	t.Run("without errors", func(t *testing.T) {
+		wg, ctx := WithContext(context.Background())
+		wg.Go(func() error { return nil })
+
+		for range wg.Errors() {
+			t.Fatal("should not yield")
+		}
+		assert.ErrorIs(t, context.Cause(ctx), context.Canceled)
+	})
+}
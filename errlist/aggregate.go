@@ -0,0 +1,193 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-pogo/errors"
+	"golang.org/x/xerrors"
+)
+
+// Aggregate is a group of equal errors that are added to an [AggregateList].
+// It is an error itself, which unwraps to the first added error of the group.
+type Aggregate struct {
+	// Err is the first added error of the group, which represents all errors
+	// of the group.
+	Err error
+	// Count is the number of errors within the group.
+	Count int
+	// First is the time at which the first error of the group is added.
+	First time.Time
+	// Last is the time at which the last error of the group is added.
+	Last time.Time
+}
+
+// Error returns the message of [Aggregate.Err], followed by the number of
+// errors in the group when there is more than one, e.g. "timeout x 50000".
+func (a *Aggregate) Error() string {
+	if a.Count <= 1 {
+		return a.Err.Error()
+	}
+	return a.Err.Error() + " x " + strconv.Itoa(a.Count)
+}
+
+// Unwrap returns [Aggregate.Err].
+func (a *Aggregate) Unwrap() error { return a.Err }
+
+// Format uses [xerrors.FormatError] to call the [FormatError] method of the
+// error with a [errors.Printer] configured according to s and v, and writes
+// the result to s.
+func (a *Aggregate) Format(s fmt.State, v rune) { xerrors.FormatError(a, s, v) }
+
+// FormatError prints the error to the [errors.Printer]. When details are
+// requested, it also prints the times of the first and last error of the
+// group, followed by the details of [Aggregate.Err].
+func (a *Aggregate) FormatError(p errors.Printer) error {
+	p.Print(a.Error())
+	if !p.Detail() {
+		return nil
+	}
+
+	p.Printf("first: %s\n", a.First.Format(time.RFC3339Nano))
+	if a.Count > 1 {
+		p.Printf("last: %s\n", a.Last.Format(time.RFC3339Nano))
+	}
+	p.Printf("%+v", a.Err)
+	return nil
+}
+
+// AggregateList is a thread-safe error list which groups equal errors. Instead
+// of storing each added error, it stores an [Aggregate] for each group of
+// equal errors, which counts the number of added errors and keeps the times
+// of the first and last added error. Errors are considered equal when they
+// have the same [errors.Fingerprint], so errors that are created separately
+// with the same message, e.g. errors.New(ErrTimeout), are grouped. Errors
+// are not compared using [errors.Is], so finding the group of an error does
+// not depend on the number of groups. Its zero value is ready to use.
+type AggregateList struct {
+	mut  sync.RWMutex
+	list []*Aggregate
+
+	// index contains the position of each group within list by the
+	// fingerprint of its error.
+	index map[uint64]int
+}
+
+// Len returns the number of groups within the [AggregateList].
+func (l *AggregateList) Len() int {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+	return len(l.list)
+}
+
+// Total returns the total number of errors that are added to the
+// [AggregateList].
+func (l *AggregateList) Total() int {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+
+	var n int
+	for _, a := range l.list {
+		n += a.Count
+	}
+	return n
+}
+
+// IsEmpty return true when [AggregateList] is empty.
+func (l *AggregateList) IsEmpty() bool {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+	return len(l.list) == 0
+}
+
+// Aggregates returns a copy of the groups within [AggregateList], in the order
+// in which their first error is added.
+func (l *AggregateList) Aggregates() []Aggregate {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+	if len(l.list) == 0 {
+		return nil
+	}
+
+	res := make([]Aggregate, len(l.list))
+	for i, a := range l.list {
+		res[i] = *a
+	}
+	return res
+}
+
+// All returns a copy of each [Aggregate] within [AggregateList] as an error.
+func (l *AggregateList) All() []error {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+	return l.all()
+}
+
+func (l *AggregateList) all() []error {
+	if len(l.list) == 0 {
+		return nil
+	}
+
+	res := make([]error, len(l.list))
+	for i, a := range l.list {
+		cp := *a
+		res[i] = &cp
+	}
+	return res
+}
+
+// Join the collected groups of errors. It uses the same rules and logic as
+// the [errors.Join] function.
+//
+//	err := list.Join()
+//	fmt.Println(err)
+//	// multiple errors occurred:
+//	// [1/2] connection refused x 50000;
+//	// [2/2] invalid input
+func (l *AggregateList) Join() error {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+	return errors.Join(l.all()...)
+}
+
+// Append adds an error to the [AggregateList]. It either increases the count
+// of the group of errors it is equal to, or starts a new group. It guarantees
+// only non-nil errors are added. It returns true when the error is added,
+// false otherwise.
+func (l *AggregateList) Append(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	fp := errors.Fingerprint(err)
+	now := time.Now()
+
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	if i, ok := l.index[fp]; ok {
+		a := l.list[i]
+		a.Count++
+		a.Last = now
+		return true
+	}
+
+	if l.list == nil {
+		l.list = make([]*Aggregate, 0, DefaultCapacity)
+		l.index = make(map[uint64]int, DefaultCapacity)
+	}
+	l.index[fp] = len(l.list)
+	l.list = append(l.list, &Aggregate{
+		Err:   err,
+		Count: 1,
+		First: now,
+		Last:  now,
+	})
+	return true
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateList(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		var list AggregateList
+		assert.True(t, list.IsEmpty())
+		assert.Nil(t, list.All())
+		assert.Nil(t, list.Aggregates())
+		assert.Nil(t, list.Join())
+		assert.False(t, list.Append(nil))
+	})
+}
+
+func TestAggregateList_Append(t *testing.T) {
+	sentinel := stderrors.New("sentinel")
+
+	t.Run("same error", func(t *testing.T) {
+		var list AggregateList
+		for i := 0; i < 3; i++ {
+			assert.True(t, list.Append(sentinel))
+		}
+		// wrapped errors are not equal to their cause
+		list.Append(fmt.Errorf("wrapped: %w", sentinel))
+
+		assert.Equal(t, 2, list.Len())
+		assert.Equal(t, 4, list.Total())
+
+		aggs := list.Aggregates()
+		assert.Same(t, sentinel, aggs[0].Err)
+		assert.Equal(t, 3, aggs[0].Count)
+		assert.False(t, aggs[0].Last.Before(aggs[0].First))
+		assert.Equal(t, 1, aggs[1].Count)
+	})
+	t.Run("separately created", func(t *testing.T) {
+		const ErrTimeout errors.Msg = "timeout"
+
+		var list AggregateList
+		for i := 0; i < 3; i++ {
+			list.Append(errors.New(ErrTimeout))
+		}
+		list.Append(stderrors.New("timeout"))
+
+		assert.Equal(t, 2, list.Len())
+		assert.Equal(t, 4, list.Total())
+		assert.Equal(t, "multiple errors occurred:\n[1/2] timeout x 3;\n[2/2] timeout", list.Join().Error())
+	})
+	t.Run("sentinel and created error", func(t *testing.T) {
+		const ErrTimeout errors.Msg = "timeout"
+
+		var list AggregateList
+		list.Append(ErrTimeout)
+		list.Append(errors.New(ErrTimeout))
+		list.Append(ErrTimeout)
+
+		// errors are not compared using errors.Is
+		assert.Equal(t, 2, list.Len())
+		assert.Equal(t, 3, list.Total())
+	})
+	t.Run("different causes", func(t *testing.T) {
+		var list AggregateList
+		list.Append(errors.Wrap(stderrors.New("disk full"), "save failed"))
+		list.Append(errors.Wrap(stderrors.New("permission denied"), "save failed"))
+
+		assert.Equal(t, 2, list.Len())
+	})
+}
+
+func TestAggregateList_All(t *testing.T) {
+	sentinel := stderrors.New("sentinel")
+
+	var list AggregateList
+	list.Append(sentinel)
+
+	all := list.All()
+	list.Append(sentinel)
+
+	assert.ErrorIs(t, all[0], sentinel)
+	assert.Equal(t, "sentinel", all[0].Error())
+	assert.Equal(t, "sentinel x 2", list.All()[0].Error())
+}
+
+func TestAggregateList_Join(t *testing.T) {
+	errDummy := stderrors.New("dummy")
+
+	var list AggregateList
+	for i := 0; i < 50000; i++ {
+		list.Append(errDummy)
+	}
+	list.Append(stderrors.New("other"))
+
+	err := list.Join()
+	assert.ErrorIs(t, err, errDummy)
+	assert.Contains(t, err.Error(), errDummy.Error()+" x 50000")
+	assert.Contains(t, fmt.Sprintf("%+v", err), errDummy.Error()+" x 50000")
+}
+
+func TestAggregate_Format(t *testing.T) {
+	var list AggregateList
+	list.Append(errors.New("some err"))
+	list.Append(list.Aggregates()[0].Err)
+
+	have := fmt.Sprintf("%+v", list.All()[0])
+	assert.True(t, strings.HasPrefix(have, "some err x 2:\n"), have)
+	assert.Contains(t, have, "first: ")
+	assert.Contains(t, have, "last: ")
+	assert.Equal(t, "some err x 2", fmt.Sprintf("%v", list.All()[0]))
+}
+
+func BenchmarkAggregateList_Append(b *testing.B) {
+	for _, n := range []int{10, 1000, 100000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			errs := make([]error, n)
+			for i := range errs {
+				errs[i] = errors.New(errors.Msg("err " + strconv.Itoa(i)))
+			}
+
+			var list AggregateList
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				list.Append(errs[i%n])
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package errlist
+
+import "iter"
+
+// Values returns an iterator over the errors within [List]. Unlike
+// [List.All], it does not copy the errors. Instead, the [List] is only locked
+// while reading the next error, so it is safe to append errors while ranging
+// over the iterator. Errors that are appended during the iteration are also
+// yielded. Prepending errors during the iteration may cause errors to be
+// yielded more than once.
+func (l *List) Values() iter.Seq[error] {
+	return func(yield func(error) bool) {
+		for i := 0; ; i++ {
+			err, ok := l.at(i)
+			if !ok || !yield(err) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the errors within [ShardedList], in the
+// order in which they are added. The errors are collected using
+// [ShardedList.All] when the iteration starts.
+func (l *ShardedList) Values() iter.Seq[error] {
+	return func(yield func(error) bool) {
+		for _, err := range l.All() {
+			if !yield(err) {
+				return
+			}
+		}
+	}
+}
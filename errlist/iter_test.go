@@ -0,0 +1,76 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package errlist
+
+import (
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Values(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		var list List
+		for range list.Values() {
+			t.Fatal("should not yield")
+		}
+	})
+
+	err1, err2, err3 := errors.New("err1"), errors.New("err2"), errors.New("err3")
+
+	t.Run("all", func(t *testing.T) {
+		list := New([]error{err1, err2})
+
+		var have []error
+		for err := range list.Values() {
+			have = append(have, err)
+		}
+		assert.Equal(t, []error{err1, err2}, have)
+	})
+	t.Run("break", func(t *testing.T) {
+		list := New([]error{err1, err2})
+
+		var have []error
+		for err := range list.Values() {
+			have = append(have, err)
+			break
+		}
+		assert.Equal(t, []error{err1}, have)
+	})
+	t.Run("append while ranging", func(t *testing.T) {
+		list := New([]error{err1})
+
+		var have []error
+		for err := range list.Values() {
+			have = append(have, err)
+			if err == err1 {
+				list.Append(err2)
+				list.Append(err3)
+			}
+		}
+		assert.Equal(t, []error{err1, err2, err3}, have)
+	})
+}
+
+func TestShardedList_Values(t *testing.T) {
+	err1, err2, err3 := errors.New("err1"), errors.New("err2"), errors.New("err3")
+
+	list := NewSharded(4)
+	list.Append(err2)
+	list.Append(err3)
+	list.Prepend(err1)
+
+	var have []error
+	for err := range list.Values() {
+		have = append(have, err)
+		if len(have) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []error{err1, err2}, have)
+}
@@ -18,10 +18,50 @@ type ErrorLister interface {
 	ErrorList() *List
 }
 
+// Collector collects errors. It is implemented by both [List] and
+// [ShardedList], so code which collects errors does not have to depend on a
+// specific list.
+type Collector interface {
+	// Len returns the number of collected errors.
+	Len() int
+	// IsEmpty returns true when no errors are collected.
+	IsEmpty() bool
+	// All returns a slice of the collected errors.
+	All() []error
+	// Join the collected errors, see [errors.Join].
+	Join() error
+
+	// Append adds a non-nil error.
+	Append(err error) bool
+	// AppendUnique adds a non-nil error which is unique within the
+	// collected errors.
+	AppendUnique(err error) bool
+	// Prepend adds a non-nil error in front of the collected errors.
+	Prepend(err error) bool
+	// PrependUnique adds a non-nil error, which is unique within the
+	// collected errors, in front of them.
+	PrependUnique(err error) bool
+
+	// Subscribe creates a new [Subscription] which delivers the added errors.
+	Subscribe(buffer int, policy DropPolicy) *Subscription
+	// OnAppend registers fn, which is called with each added error.
+	OnAppend(fn func(err error)) (unregister func())
+	// Close closes all subscriptions and unregisters all callbacks.
+	Close()
+}
+
+var (
+	_ Collector = (*List)(nil)
+	_ Collector = (*ShardedList)(nil)
+)
+
 // List is a thread-safe error list. Its zero value is ready to use.
 type List struct {
-	mut  sync.RWMutex
-	list []error
+	mut       sync.RWMutex
+	list      []error
+	listeners listeners
+
+	recordTime bool
 }
 
 // New creates a new [List] using the provided slice.
@@ -61,6 +101,16 @@ func (l *List) All() []error {
 	return res
 }
 
+// at returns the error at index i, or false when i is out of range.
+func (l *List) at(i int) (error, bool) {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+	if i >= len(l.list) {
+		return nil, false
+	}
+	return l.list[i], true
+}
+
 // Join the collected errors. It uses the same rules and logic as the
 // [errors.Join] function.
 func (l *List) Join() error {
@@ -69,98 +119,115 @@ func (l *List) Join() error {
 	return errors.Join(l.list...)
 }
 
-// Append an error to the [List]. It guarantees only non-nil errors are added.
-// It returns true when the error is appended to [List], false otherwise.
-func (l *List) Append(err error) bool {
-	if err == nil {
-		return false
+// FilterSeverity returns a slice of the errors within [List] which have a
+// [errors.Severity] of at least min. The severity of each error is
+// determined using [errors.GetSeverity].
+//
+//	warnings := list.FilterSeverity(errors.SeverityWarning)
+func (l *List) FilterSeverity(min errors.Severity) []error {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+
+	var res []error
+	for _, err := range l.list {
+		if errors.GetSeverity(err) >= min {
+			res = append(res, err)
+		}
 	}
+	return res
+}
 
-	l.mut.Lock()
-	defer l.mut.Unlock()
+// JoinSeverity joins the collected errors, like [List.Join], but only when at
+// least one of the errors has a [errors.Severity] of min or higher. Otherwise,
+// it returns nil. Use it with [errors.SeverityError] to ignore a [List] which
+// only contains warnings.
+func (l *List) JoinSeverity(min errors.Severity) error {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
 
-	if l.list == nil {
-		l.list = make([]error, 0, DefaultCapacity)
+	for _, err := range l.list {
+		if errors.GetSeverity(err) >= min {
+			return errors.Join(l.list...)
+		}
 	}
-	l.list = append(l.list, err)
-	return true
+	return nil
 }
 
+// Append an error to the [List]. It guarantees only non-nil errors are added.
+// It returns true when the error is appended to [List], false otherwise.
+func (l *List) Append(err error) bool { return l.add(err, false, false) }
+
 // AppendUnique appends an error to [List] and guarantees that the error is
 // non-nil and unique within the [List].
 // It returns true when the error is appended, false otherwise.
-func (l *List) AppendUnique(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	l.mut.Lock()
-	defer l.mut.Unlock()
-
-	if l.list == nil {
-		l.list = make([]error, 0, DefaultCapacity)
-		l.list = append(l.list, err)
-		return true
-	}
-	if l.isUnique(err) {
-		l.list = append(l.list, err)
-		return true
-	}
-	return false
-}
+func (l *List) AppendUnique(err error) bool { return l.add(err, true, false) }
 
 // Prepend an error to the [List]. It guarantees only non-nil errors are added.
 // It returns true when the error is appended, false otherwise.
-func (l *List) Prepend(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	l.mut.Lock()
-	defer l.mut.Unlock()
-
-	if l.list == nil {
-		l.list = make([]error, 0, DefaultCapacity)
-		l.list = append(l.list, err)
-	} else {
-		l.list = prepend(l.list, err)
-	}
-	return true
-}
+func (l *List) Prepend(err error) bool { return l.add(err, false, true) }
 
 // PrependUnique prepends an error to [List] and guarantees that the error is
 // non-nil and unique within the [List].
 // It returns true when the error is appended, false otherwise.
-func (l *List) PrependUnique(err error) bool {
+func (l *List) PrependUnique(err error) bool { return l.add(err, true, true) }
+
+// add appends, or prepends when front is true, err to the [List] and notifies
+// the listeners registered with [List.Subscribe] and [List.OnAppend]. The
+// listeners are called after the [List] is unlocked, so they can safely
+// access it.
+func (l *List) add(err error, unique, front bool) bool {
 	if err == nil {
 		return false
 	}
 
 	l.mut.Lock()
-	defer l.mut.Unlock()
-
+	if unique && !l.isUnique(err) {
+		l.mut.Unlock()
+		return false
+	}
+	if l.recordTime {
+		err = withTime(err)
+	}
 	if l.list == nil {
 		l.list = make([]error, 0, DefaultCapacity)
-		l.list = append(l.list, err)
-		return true
 	}
-	if l.isUnique(err) {
+	if front {
 		l.list = prepend(l.list, err)
-		return true
+	} else {
+		l.list = append(l.list, err)
 	}
-	return false
+	l.mut.Unlock()
+
+	l.listeners.notify(err)
+	return true
 }
 
 func (l *List) isUnique(err error) bool {
 	matchCause := errors.IsCause(err)
 	for _, e := range l.list {
-		if errors.IsCause(e) == matchCause && errors.Is(err, e) {
+		if errors.IsCause(e) != matchCause {
+			continue
+		}
+		if errors.Is(err, e) {
 			return false
 		}
+		// compare with the error that is decorated with the time it was
+		// appended, see SetRecordTime
+		if l.recordTime {
+			//goland:noinspection GoTypeAssertionOnErrors
+			if t, ok := e.(timeEmbedder); ok && errors.Is(err, t.Unembed()) {
+				return false
+			}
+		}
 	}
 	return true
 }
 
+type timeEmbedder interface {
+	errors.Timer
+	errors.Embedder
+}
+
 func prepend(errs []error, err error) []error {
 	errs = append(errs, err)
 	if len(errs) > 1 {
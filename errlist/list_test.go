@@ -115,3 +115,49 @@ func TestList_Join(t *testing.T) {
 	assert.Exactly(t, []error{errs[0], errs[2]}, multi.Unwrap())
 	assert.Equal(t, errors.Join(errs...), multi)
 }
+
+func TestList_FilterSeverity(t *testing.T) {
+	warning := errors.WithSeverity(errors.New("warning"), errors.SeverityWarning)
+	critical := errors.WithSeverity(errors.New("critical"), errors.SeverityCritical)
+	err := errors.New("error")
+
+	var list List
+	list.Append(warning)
+	list.Append(err)
+	list.Append(critical)
+
+	assert.Equal(t, []error{warning, err, critical}, list.FilterSeverity(errors.SeverityDebug))
+	assert.Equal(t, []error{err, critical}, list.FilterSeverity(errors.SeverityError))
+	assert.Equal(t, []error{critical}, list.FilterSeverity(errors.SeverityCritical))
+
+	t.Run("empty", func(t *testing.T) {
+		var list List
+		assert.Empty(t, list.FilterSeverity(errors.SeverityDebug))
+	})
+}
+
+func TestList_JoinSeverity(t *testing.T) {
+	internal.DisableTraceStack()
+	defer internal.EnableTraceStack()
+
+	warning := errors.WithSeverity(errors.New("warning"), errors.SeverityWarning)
+
+	t.Run("only warnings", func(t *testing.T) {
+		var list List
+		list.Append(warning)
+		assert.Nil(t, list.JoinSeverity(errors.SeverityError))
+		assert.Same(t, warning, list.JoinSeverity(errors.SeverityWarning))
+	})
+	t.Run("with error", func(t *testing.T) {
+		err := errors.New("error")
+
+		var list List
+		list.Append(warning)
+		list.Append(err)
+		assert.Equal(t, errors.Join(warning, err), list.JoinSeverity(errors.SeverityError))
+	})
+	t.Run("empty", func(t *testing.T) {
+		var list List
+		assert.Nil(t, list.JoinSeverity(errors.SeverityDebug))
+	})
+}
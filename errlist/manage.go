@@ -0,0 +1,132 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-pogo/errors"
+	"golang.org/x/xerrors"
+)
+
+// SetRecordTime enables or disables recording the time at which errors are
+// added to the [List]. When enabled, each added error is decorated with the
+// current time, which can be retrieved using [errors.GetTime]. Unlike
+// [errors.WithTime], the added error itself is never modified.
+func (l *List) SetRecordTime(enable bool) {
+	l.mut.Lock()
+	l.recordTime = enable
+	l.mut.Unlock()
+}
+
+// timeError decorates an error with the time at which it is added to a list.
+// It is an [errors.Timer] and [errors.Embedder], but unlike the error returned
+// by [errors.WithTime], it does not implement [errors.TimerSetter].
+type timeError struct {
+	error
+	time time.Time
+}
+
+func withTime(err error) *timeError {
+	return &timeError{error: err, time: time.Now()}
+}
+
+func (e *timeError) Time() time.Time { return e.time }
+
+// StackTrace returns the [errors.StackTrace] of the embedded error, if any.
+func (e *timeError) StackTrace() *errors.StackTrace {
+	return errors.GetStackTrace(errors.Unembed(e.error))
+}
+
+// Unembed the underlying error.
+func (e *timeError) Unembed() error { return e.error }
+
+// Unwrap the underlying error.
+func (e *timeError) Unwrap() error { return e.error }
+
+// Format uses [xerrors.FormatError] to call the [FormatError] method of the
+// error with a [errors.Printer] configured according to s and v, and writes
+// the result to s.
+func (e *timeError) Format(s fmt.State, v rune) { xerrors.FormatError(e, s, v) }
+
+// FormatError formats the embedded error to the [errors.Printer] and returns
+// the next error in the error chain, if any.
+func (e *timeError) FormatError(p errors.Printer) error {
+	//goland:noinspection GoTypeAssertionOnErrors
+	if f, ok := e.error.(errors.Formatter); ok {
+		return f.FormatError(p)
+	}
+
+	errors.PrintError(p, e.error)
+	return errors.Unwrap(e.error)
+}
+
+// Drain returns the errors within the [List] and clears it, in a single
+// atomic operation. It is useful to periodically report all errors that are
+// added since the last call to Drain.
+//
+//	for range ticker.C {
+//		for _, err := range list.Drain() {
+//			reporter.Report(err)
+//		}
+//	}
+func (l *List) Drain() []error {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	res := l.list
+	l.list = nil
+	return res
+}
+
+// Reset removes all errors from the [List], while keeping its allocated
+// capacity so it can be reused.
+func (l *List) Reset() {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	for i := range l.list {
+		l.list[i] = nil
+	}
+	l.list = l.list[:0]
+}
+
+// Remove removes all errors for which pred returns true from the [List]. It
+// returns the number of removed errors.
+func (l *List) Remove(pred func(err error) bool) int {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	n := 0
+	for _, err := range l.list {
+		if !pred(err) {
+			l.list[n] = err
+			n++
+		}
+	}
+
+	removed := len(l.list) - n
+	for i := n; i < len(l.list); i++ {
+		l.list[i] = nil
+	}
+	l.list = l.list[:n]
+	return removed
+}
+
+// Snapshot returns a new [List] which contains a copy of the errors within
+// the [List]. The snapshot records times when the [List] does, see
+// [List.SetRecordTime], but does not share its subscriptions and callbacks.
+func (l *List) Snapshot() *List {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+
+	res := &List{recordTime: l.recordTime}
+	if l.list != nil {
+		res.list = make([]error, len(l.list), cap(l.list))
+		copy(res.list, l.list)
+	}
+	return res
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Drain(t *testing.T) {
+	err1, err2 := stderrors.New("err1"), stderrors.New("err2")
+	list := New([]error{err1, err2})
+
+	assert.Equal(t, []error{err1, err2}, list.Drain())
+	assertEmptyList(t, list)
+	assert.Nil(t, list.Drain())
+
+	list.Append(err1)
+	assert.Equal(t, []error{err1}, list.Drain())
+}
+
+func TestList_Reset(t *testing.T) {
+	list := NewWithCapacity(4)
+	list.Append(stderrors.New("err"))
+	list.Reset()
+
+	assertEmptyList(t, list)
+	assert.Equal(t, 4, cap(list.list))
+
+	var zero List
+	zero.Reset()
+	assertEmptyList(t, &zero)
+}
+
+func TestList_Remove(t *testing.T) {
+	err1, err2, err3 := stderrors.New("err1"), stderrors.New("err2"), stderrors.New("err3")
+	list := New([]error{err1, err2, err3, err2})
+
+	assert.Equal(t, 2, list.Remove(func(err error) bool { return err == err2 }))
+	assert.Equal(t, []error{err1, err3}, list.All())
+	assert.Equal(t, 0, list.Remove(func(err error) bool { return err == err2 }))
+}
+
+func TestList_Snapshot(t *testing.T) {
+	err1, err2 := stderrors.New("err1"), stderrors.New("err2")
+	list := New([]error{err1})
+	list.SetRecordTime(true)
+
+	snap := list.Snapshot()
+	list.Append(err2)
+	snap.Append(err2)
+
+	assert.Equal(t, 2, list.Len())
+	assert.Equal(t, 2, snap.Len())
+	assert.True(t, snap.recordTime)
+
+	var zero List
+	assertEmptyList(t, zero.Snapshot())
+}
+
+func TestList_SetRecordTime(t *testing.T) {
+	var list List
+	list.SetRecordTime(true)
+
+	before := time.Now()
+	err := stderrors.New("err")
+	list.Append(err)
+
+	have := list.All()[0]
+	assert.ErrorIs(t, have, err)
+
+	when, ok := errors.GetTime(have)
+	assert.True(t, ok)
+	assert.False(t, when.Before(before))
+
+	t.Run("unique", func(t *testing.T) {
+		assert.False(t, list.AppendUnique(err))
+		assert.True(t, list.AppendUnique(stderrors.New("other")))
+		assert.Equal(t, 2, list.Len())
+	})
+	t.Run("disable", func(t *testing.T) {
+		list.SetRecordTime(false)
+		list.Append(err)
+		assert.Same(t, err, list.All()[2])
+	})
+	t.Run("timer setter", func(t *testing.T) {
+		var list List
+		list.SetRecordTime(true)
+
+		when := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		err := errors.WithTime(errors.New("err"), when)
+		list.Append(err)
+
+		have := list.All()[0]
+		assert.NotSame(t, err, have)
+		assert.Equal(t, when, err.Time())
+		assert.True(t, have.(errors.Timer).Time().After(when))
+		assert.Equal(t, fmt.Sprintf("%v", err), fmt.Sprintf("%v", have))
+	})
+}
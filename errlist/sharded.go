@@ -0,0 +1,351 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-pogo/errors"
+)
+
+// ShardedList is a thread-safe error list which is optimized for many
+// goroutines that concurrently add errors to it. Its errors are divided over
+// multiple shards, each guarded by its own lock, so appending goroutines
+// rarely have to wait for each other. A sequence number is assigned to each
+// error to keep the order in which the errors are added.
+// Uniqueness of errors is determined using their [errors.Fingerprint], instead
+// of comparing them with [errors.Is] like [List] does.
+// Its zero value is ready to use and has a shard for each CPU, as reported by
+// [runtime.GOMAXPROCS].
+//
+// ShardedList provides the same methods as [List] to add, read and manage
+// errors, and to subscribe to added errors. Both implement [Collector], so a
+// ShardedList can be used to collect the errors of an errgroup.Group.
+type ShardedList struct {
+	once   sync.Once
+	shards []shard
+	mask   uint64
+
+	// appendSeq and prependSeq provide the sequence numbers of appended and
+	// prepended errors. Prepended errors get negative sequence numbers so
+	// they are ordered before all appended errors.
+	appendSeq  atomic.Int64
+	prependSeq atomic.Int64
+
+	recordTime atomic.Bool
+	listeners  listeners
+}
+
+type shard struct {
+	mut  sync.Mutex
+	list []entry
+	seen map[uint64]struct{}
+
+	// pad prevents false sharing between the locks of adjacent shards
+	_ [64]byte
+}
+
+type entry struct {
+	seq int64
+	err error
+	// fp is the fingerprint of err when it is added as unique error.
+	fp     uint64
+	unique bool
+}
+
+// NewSharded creates a new [ShardedList] with at least the provided number
+// of shards. The number of shards is rounded up to a power of two.
+func NewSharded(shards uint) *ShardedList {
+	var l ShardedList
+	l.init(shards)
+	return &l
+}
+
+func (l *ShardedList) init(n uint) {
+	l.once.Do(func() {
+		if n == 0 {
+			n = uint(runtime.GOMAXPROCS(0))
+		}
+
+		size := uint(1)
+		for size < n {
+			size <<= 1
+		}
+
+		l.shards = make([]shard, size)
+		l.mask = uint64(size - 1)
+	})
+}
+
+// lockAll locks all shards, in order, and initializes the [ShardedList] when
+// needed.
+func (l *ShardedList) lockAll() {
+	l.init(0)
+	for i := range l.shards {
+		l.shards[i].mut.Lock()
+	}
+}
+
+func (l *ShardedList) unlockAll() {
+	for i := range l.shards {
+		l.shards[i].mut.Unlock()
+	}
+}
+
+// Len returns the number of errors within the [ShardedList].
+func (l *ShardedList) Len() int {
+	l.lockAll()
+	defer l.unlockAll()
+
+	var n int
+	for i := range l.shards {
+		n += len(l.shards[i].list)
+	}
+	return n
+}
+
+// IsEmpty return true when [ShardedList] is empty.
+func (l *ShardedList) IsEmpty() bool { return l.Len() == 0 }
+
+// All returns a slice of the errors within [ShardedList], in the order in
+// which they are added.
+func (l *ShardedList) All() []error {
+	l.lockAll()
+	defer l.unlockAll()
+	return l.all()
+}
+
+// all merges the errors of all shards, ordered by their sequence number. The
+// shards must be locked.
+func (l *ShardedList) all() []error {
+	var n int
+	for i := range l.shards {
+		n += len(l.shards[i].list)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	entries := make([]entry, 0, n)
+	for i := range l.shards {
+		entries = append(entries, l.shards[i].list...)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+
+	res := make([]error, len(entries))
+	for i, e := range entries {
+		res[i] = e.err
+	}
+	return res
+}
+
+// Join the collected errors. It uses the same rules and logic as the
+// [errors.Join] function.
+func (l *ShardedList) Join() error { return errors.Join(l.All()...) }
+
+// FilterSeverity returns a slice of the errors within [ShardedList] which
+// have a [errors.Severity] of at least min, see [List.FilterSeverity].
+func (l *ShardedList) FilterSeverity(min errors.Severity) []error {
+	var res []error
+	for _, err := range l.All() {
+		if errors.GetSeverity(err) >= min {
+			res = append(res, err)
+		}
+	}
+	return res
+}
+
+// JoinSeverity joins the collected errors, like [ShardedList.Join], but only
+// when at least one of the errors has a [errors.Severity] of min or higher,
+// see [List.JoinSeverity].
+func (l *ShardedList) JoinSeverity(min errors.Severity) error {
+	errs := l.All()
+	for _, err := range errs {
+		if errors.GetSeverity(err) >= min {
+			return errors.Join(errs...)
+		}
+	}
+	return nil
+}
+
+// Append an error to the [ShardedList]. It guarantees only non-nil errors are
+// added. It returns true when the error is appended to [ShardedList], false
+// otherwise.
+func (l *ShardedList) Append(err error) bool { return l.add(err, false, false) }
+
+// AppendUnique appends an error to [ShardedList] and guarantees that the error
+// is non-nil and its [errors.Fingerprint] is unique within the [ShardedList].
+// Only errors that are added using AppendUnique or [ShardedList.PrependUnique]
+// are taken into account, as fingerprints of errors added using
+// [ShardedList.Append] or [ShardedList.Prepend] are not calculated.
+// It returns true when the error is appended, false otherwise.
+func (l *ShardedList) AppendUnique(err error) bool { return l.add(err, true, false) }
+
+// Prepend an error to the [ShardedList]. It guarantees only non-nil errors are
+// added. It returns true when the error is prepended, false otherwise.
+func (l *ShardedList) Prepend(err error) bool { return l.add(err, false, true) }
+
+// PrependUnique prepends an error to [ShardedList] and guarantees that the
+// error is non-nil and its [errors.Fingerprint] is unique within the
+// [ShardedList], see [ShardedList.AppendUnique].
+// It returns true when the error is prepended, false otherwise.
+func (l *ShardedList) PrependUnique(err error) bool { return l.add(err, true, true) }
+
+// add adds err to a shard and notifies the listeners registered with
+// [ShardedList.Subscribe] and [ShardedList.OnAppend], after the shard is
+// unlocked. Unique errors are always added to the shard that is selected by
+// their fingerprint, so only a single shard needs to be locked to check if the
+// error is unique. Other errors are spread over the shards using their
+// sequence number.
+func (l *ShardedList) add(err error, unique, front bool) bool {
+	if err == nil {
+		return false
+	}
+
+	l.init(0)
+
+	var fp uint64
+	if unique {
+		fp = errors.Fingerprint(err)
+	}
+
+	var seq int64
+	if front {
+		seq = -l.prependSeq.Add(1)
+	} else {
+		seq = l.appendSeq.Add(1)
+	}
+
+	var s *shard
+	if unique {
+		s = &l.shards[fp&l.mask]
+	} else {
+		s = &l.shards[uint64(seq)&l.mask]
+	}
+
+	s.mut.Lock()
+	if unique {
+		if _, exists := s.seen[fp]; exists {
+			s.mut.Unlock()
+			return false
+		}
+		if s.seen == nil {
+			s.seen = make(map[uint64]struct{}, DefaultCapacity)
+		}
+		s.seen[fp] = struct{}{}
+	}
+	if l.recordTime.Load() {
+		err = withTime(err)
+	}
+	if s.list == nil {
+		s.list = make([]entry, 0, DefaultCapacity)
+	}
+	s.list = append(s.list, entry{seq: seq, err: err, fp: fp, unique: unique})
+	s.mut.Unlock()
+
+	l.listeners.notify(err)
+	return true
+}
+
+// SetRecordTime enables or disables recording the time at which errors are
+// added to the [ShardedList], see [List.SetRecordTime].
+func (l *ShardedList) SetRecordTime(enable bool) { l.recordTime.Store(enable) }
+
+// Drain returns the errors within the [ShardedList], in the order in which
+// they are added, and clears it, in a single atomic operation.
+func (l *ShardedList) Drain() []error {
+	l.lockAll()
+	defer l.unlockAll()
+
+	res := l.all()
+	for i := range l.shards {
+		l.shards[i].list = nil
+		l.shards[i].seen = nil
+	}
+	return res
+}
+
+// Reset removes all errors from the [ShardedList], while keeping the allocated
+// capacity of its shards so they can be reused.
+func (l *ShardedList) Reset() {
+	l.lockAll()
+	defer l.unlockAll()
+
+	for i := range l.shards {
+		s := &l.shards[i]
+		for j := range s.list {
+			s.list[j] = entry{}
+		}
+		s.list = s.list[:0]
+		for fp := range s.seen {
+			delete(s.seen, fp)
+		}
+	}
+}
+
+// Remove removes all errors for which pred returns true from the
+// [ShardedList]. It returns the number of removed errors.
+func (l *ShardedList) Remove(pred func(err error) bool) int {
+	l.lockAll()
+	defer l.unlockAll()
+
+	var removed int
+	for i := range l.shards {
+		s := &l.shards[i]
+
+		n := 0
+		for _, e := range s.list {
+			if !pred(e.err) {
+				s.list[n] = e
+				n++
+			} else if e.unique {
+				// unique errors are always stored in the shard that is
+				// selected by their fingerprint
+				delete(s.seen, e.fp)
+			}
+		}
+
+		removed += len(s.list) - n
+		for j := n; j < len(s.list); j++ {
+			s.list[j] = entry{}
+		}
+		s.list = s.list[:n]
+	}
+	return removed
+}
+
+// Snapshot returns a new [ShardedList], with the same number of shards, which
+// contains a copy of the errors within the [ShardedList]. The snapshot records
+// times when the [ShardedList] does, see [ShardedList.SetRecordTime], but does
+// not share its subscriptions and callbacks.
+func (l *ShardedList) Snapshot() *ShardedList {
+	l.lockAll()
+	defer l.unlockAll()
+
+	res := NewSharded(uint(len(l.shards)))
+	res.appendSeq.Store(l.appendSeq.Load())
+	res.prependSeq.Store(l.prependSeq.Load())
+	res.recordTime.Store(l.recordTime.Load())
+
+	for i := range l.shards {
+		src, dst := &l.shards[i], &res.shards[i]
+		if src.list != nil {
+			dst.list = make([]entry, len(src.list), cap(src.list))
+			copy(dst.list, src.list)
+		}
+		if src.seen != nil {
+			dst.seen = make(map[uint64]struct{}, len(src.seen))
+			for fp := range src.seen {
+				dst.seen[fp] = struct{}{}
+			}
+		}
+	}
+	return res
+}
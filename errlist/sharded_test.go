@@ -0,0 +1,233 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	stderrors "errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSharded(t *testing.T) {
+	tests := map[uint]int{0: -1, 1: 1, 3: 4, 8: 8, 9: 16}
+	for n, want := range tests {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			list := NewSharded(n)
+			if want < 0 {
+				assert.NotEmpty(t, list.shards)
+			} else {
+				assert.Len(t, list.shards, want)
+			}
+			assert.True(t, list.IsEmpty())
+		})
+	}
+}
+
+func TestShardedList(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		var list ShardedList
+		assert.True(t, list.IsEmpty())
+		assert.Nil(t, list.All())
+		assert.Nil(t, list.Join())
+		assert.NotEmpty(t, list.shards)
+	})
+}
+
+func TestShardedList_Append(t *testing.T) {
+	err1, err2, err3 := errors.New("err1"), errors.New("err2"), errors.New("err3")
+
+	var list ShardedList
+	assert.False(t, list.Append(nil))
+	assert.False(t, list.Prepend(nil))
+
+	assert.True(t, list.Append(err2))
+	assert.True(t, list.Prepend(err1))
+	assert.True(t, list.Append(err3))
+	assert.True(t, list.Append(err3))
+	assert.Equal(t, 4, list.Len())
+	assert.Equal(t, []error{err1, err2, err3, err3}, list.All())
+}
+
+func TestShardedList_AppendUnique(t *testing.T) {
+	var list ShardedList
+	assert.False(t, list.AppendUnique(nil))
+	assert.True(t, list.AppendUnique(errors.New("err1")))
+	assert.False(t, list.AppendUnique(errors.New("err1")))
+	assert.False(t, list.PrependUnique(errors.New("err1")))
+	assert.True(t, list.PrependUnique(errors.New("err2")))
+	assert.Equal(t, 2, list.Len())
+	assert.Equal(t, "err2", list.All()[0].Error())
+
+	t.Run("same message different cause", func(t *testing.T) {
+		var list ShardedList
+		assert.True(t, list.AppendUnique(errors.Wrap(stderrors.New("disk full"), "save failed")))
+		assert.True(t, list.AppendUnique(errors.Wrap(stderrors.New("permission denied"), "save failed")))
+		assert.Equal(t, 2, list.Len())
+	})
+}
+
+func TestShardedList_Drain(t *testing.T) {
+	err := errors.New("err")
+
+	var list ShardedList
+	list.AppendUnique(err)
+	assert.Equal(t, []error{err}, list.Drain())
+	assert.True(t, list.IsEmpty())
+	assert.Nil(t, list.Drain())
+
+	// fingerprints are cleared as well
+	assert.True(t, list.AppendUnique(err))
+}
+
+func TestShardedList_JoinSeverity(t *testing.T) {
+	warn := errors.WithSeverity(errors.New("warn"), errors.SeverityWarning)
+	fail := errors.New("fail")
+
+	var list ShardedList
+	list.Append(warn)
+	assert.Nil(t, list.JoinSeverity(errors.SeverityError))
+	assert.Equal(t, []error{warn}, list.FilterSeverity(errors.SeverityWarning))
+
+	list.Append(fail)
+	assert.Equal(t, errors.Join(warn, fail).Error(), list.JoinSeverity(errors.SeverityError).Error())
+	assert.Equal(t, []error{fail}, list.FilterSeverity(errors.SeverityError))
+}
+
+func TestShardedList_concurrent(t *testing.T) {
+	const goroutines, perGoroutine = 16, 100
+
+	list := NewSharded(4)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				list.Append(errors.New("err"))
+				list.AppendUnique(errors.New(fmt.Sprintf("unique %d", j)))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, goroutines*perGoroutine+perGoroutine, list.Len())
+}
+
+type appender interface {
+	Append(err error) bool
+	AppendUnique(err error) bool
+}
+
+func BenchmarkAppend(b *testing.B) {
+	err := errors.New("some err")
+	lists := map[string]func() appender{
+		"List":        func() appender { return new(List) },
+		"ShardedList": func() appender { return new(ShardedList) },
+	}
+
+	for name, newList := range lists {
+		b.Run(name, func(b *testing.B) {
+			list := newList()
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					list.Append(err)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkAppendUnique(b *testing.B) {
+	errs := make([]error, 128)
+	for i := range errs {
+		errs[i] = errors.New(fmt.Sprintf("err %d", i))
+	}
+
+	lists := map[string]func() appender{
+		"List":        func() appender { return new(List) },
+		"ShardedList": func() appender { return new(ShardedList) },
+	}
+
+	for name, newList := range lists {
+		b.Run(name, func(b *testing.B) {
+			list := newList()
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				var i int
+				for pb.Next() {
+					list.AppendUnique(errs[i%len(errs)])
+					i++
+				}
+			})
+		})
+	}
+}
+
+func TestShardedList_Reset(t *testing.T) {
+	err := errors.New("err")
+
+	list := NewSharded(2)
+	list.AppendUnique(err)
+	list.Append(errors.New("other"))
+	list.Reset()
+
+	assert.True(t, list.IsEmpty())
+	assert.True(t, list.AppendUnique(err))
+}
+
+func TestShardedList_Remove(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+
+	list := NewSharded(2)
+	list.AppendUnique(err1)
+	list.Append(err2)
+	list.Append(err2)
+
+	assert.Equal(t, 1, list.Remove(func(err error) bool { return err == err1 }))
+	assert.Equal(t, []error{err2, err2}, list.All())
+	assert.True(t, list.AppendUnique(err1))
+	assert.Equal(t, 0, list.Remove(func(error) bool { return false }))
+}
+
+func TestShardedList_Snapshot(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+
+	list := NewSharded(2)
+	list.AppendUnique(err1)
+	list.SetRecordTime(true)
+
+	snap := list.Snapshot()
+	assert.Len(t, snap.shards, 2)
+	assert.False(t, snap.AppendUnique(err1))
+
+	snap.Append(err2)
+	assert.Equal(t, 1, list.Len())
+	assert.Equal(t, 2, snap.Len())
+	assert.ErrorIs(t, snap.All()[1], err2)
+
+	_, ok := errors.GetTime(snap.All()[1])
+	assert.True(t, ok)
+}
+
+func TestShardedList_SetRecordTime(t *testing.T) {
+	when := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := errors.WithTime(errors.New("err"), when)
+
+	var list ShardedList
+	list.SetRecordTime(true)
+	list.AppendUnique(err)
+	assert.False(t, list.AppendUnique(err))
+
+	have := list.All()[0]
+	assert.ErrorIs(t, have, err)
+	assert.True(t, have.(errors.Timer).Time().After(when))
+	assert.Equal(t, when, err.Time())
+}
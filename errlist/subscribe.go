@@ -0,0 +1,259 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type listener struct {
+	fn  func(err error)
+	sub *Subscription
+}
+
+// DropPolicy determines what happens when an error is delivered to a
+// [Subscription] of which the channel's buffer is full.
+type DropPolicy uint8
+
+const (
+	// Block blocks the goroutine that adds the error to the [List], until
+	// the error is received from the channel or the [Subscription] is closed.
+	Block DropPolicy = iota
+	// DropNewest drops the error that is being delivered.
+	DropNewest
+	// DropOldest drops the oldest error in the channel's buffer to make room
+	// for the error that is being delivered.
+	DropOldest
+)
+
+// listeners are notified of the errors that are added to a [List] or
+// [ShardedList].
+type listeners struct {
+	mut sync.Mutex
+	// list is never modified in place, so it can be read without holding mut
+	// after it is loaded.
+	list   atomic.Pointer[[]*listener]
+	closed bool
+}
+
+func (ls *listeners) add(ln *listener) bool {
+	ls.mut.Lock()
+	defer ls.mut.Unlock()
+
+	if ls.closed {
+		return false
+	}
+
+	var list []*listener
+	if p := ls.list.Load(); p != nil {
+		list = make([]*listener, 0, len(*p)+1)
+		list = append(list, *p...)
+	}
+	list = append(list, ln)
+	ls.list.Store(&list)
+	return true
+}
+
+func (ls *listeners) remove(ln *listener) {
+	ls.mut.Lock()
+	defer ls.mut.Unlock()
+
+	p := ls.list.Load()
+	if p == nil {
+		return
+	}
+
+	list := make([]*listener, 0, len(*p))
+	for _, x := range *p {
+		if x != ln {
+			list = append(list, x)
+		}
+	}
+	ls.list.Store(&list)
+}
+
+// notify calls all listeners with err.
+func (ls *listeners) notify(err error) {
+	if p := ls.list.Load(); p != nil {
+		for _, ln := range *p {
+			ln.fn(err)
+		}
+	}
+}
+
+func (ls *listeners) subscribe(buffer int, policy DropPolicy) *Subscription {
+	if buffer < 0 || (buffer == 0 && policy == DropOldest) {
+		buffer = 1
+	}
+
+	s := &Subscription{
+		from:   ls,
+		ch:     make(chan error, buffer),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	s.C = s.ch
+	s.ln = &listener{fn: s.send, sub: s}
+
+	if !ls.add(s.ln) {
+		s.Close()
+	}
+	return s
+}
+
+func (ls *listeners) onAppend(fn func(err error)) (unregister func()) {
+	ln := &listener{fn: fn}
+	if !ls.add(ln) {
+		return func() {}
+	}
+	return func() { ls.remove(ln) }
+}
+
+func (ls *listeners) close() {
+	ls.mut.Lock()
+	p := ls.list.Swap(nil)
+	ls.closed = true
+	ls.mut.Unlock()
+
+	if p == nil {
+		return
+	}
+	for _, ln := range *p {
+		if ln.sub != nil {
+			ln.sub.Close()
+		}
+	}
+}
+
+// Subscription delivers errors that are added to a [List] or [ShardedList] to
+// its channel C. Create one using [List.Subscribe] or [ShardedList.Subscribe].
+type Subscription struct {
+	// C is the channel on which the errors are delivered. It is closed when
+	// the [Subscription] is closed.
+	C <-chan error
+
+	from    *listeners
+	ln      *listener
+	ch      chan error
+	policy  DropPolicy
+	mut     sync.RWMutex
+	done    chan struct{}
+	once    sync.Once
+	dropped atomic.Uint64
+}
+
+// Subscribe creates a new [Subscription] which delivers all errors that are
+// added to the [List], after Subscribe is called, to its channel. The channel
+// has a buffer of size buffer, when the buffer is full errors are handled
+// according to policy. [DropOldest] requires a buffer of at least 1, a
+// smaller buffer is increased to 1. The [Subscription] must be closed with
+// [Subscription.Close] or [List.Close] when it is no longer needed.
+//
+//	sub := list.Subscribe(64, errlist.DropOldest)
+//	defer sub.Close()
+//
+//	go func() {
+//		for err := range sub.C {
+//			reporter.Report(err)
+//		}
+//	}()
+//
+// Subscribe returns a closed [Subscription] when the [List] is closed.
+func (l *List) Subscribe(buffer int, policy DropPolicy) *Subscription {
+	return l.listeners.subscribe(buffer, policy)
+}
+
+// OnAppend registers fn, which is called with each error that is added to
+// the [List]. It is called synchronously by the goroutine that adds the
+// error, after the [List] is unlocked. It returns a function which
+// unregisters fn. When the [List] is closed fn is not registered.
+func (l *List) OnAppend(fn func(err error)) (unregister func()) {
+	return l.listeners.onAppend(fn)
+}
+
+// Close closes all subscriptions and unregisters all callbacks, which are
+// registered with [List.Subscribe] and [List.OnAppend]. Errors can still be
+// added to the [List] but are no longer delivered. It is safe to call Close
+// more than once.
+func (l *List) Close() { l.listeners.close() }
+
+// Subscribe creates a new [Subscription] which delivers all errors that are
+// added to the [ShardedList], after Subscribe is called, to its channel. See
+// [List.Subscribe] for details.
+func (l *ShardedList) Subscribe(buffer int, policy DropPolicy) *Subscription {
+	return l.listeners.subscribe(buffer, policy)
+}
+
+// OnAppend registers fn, which is called with each error that is added to
+// the [ShardedList]. It is called synchronously by the goroutine that adds
+// the error, after its shard is unlocked. It returns a function which
+// unregisters fn. When the [ShardedList] is closed fn is not registered.
+func (l *ShardedList) OnAppend(fn func(err error)) (unregister func()) {
+	return l.listeners.onAppend(fn)
+}
+
+// Close closes all subscriptions and unregisters all callbacks, which are
+// registered with [ShardedList.Subscribe] and [ShardedList.OnAppend], see
+// [List.Close].
+func (l *ShardedList) Close() { l.listeners.close() }
+
+// Dropped returns the number of errors that are dropped because the
+// channel's buffer was full.
+func (s *Subscription) Dropped() uint64 { return s.dropped.Load() }
+
+// Close unsubscribes from the list and closes channel C. Any goroutine that
+// is blocked while delivering an error to the [Subscription] is released. It
+// is safe to call Close more than once.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.from.remove(s.ln)
+
+		s.mut.Lock()
+		close(s.ch)
+		s.mut.Unlock()
+	})
+}
+
+func (s *Subscription) send(err error) {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	switch s.policy {
+	case DropNewest:
+		select {
+		case s.ch <- err:
+		default:
+			s.dropped.Add(1)
+		}
+
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- err:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+
+	default:
+		select {
+		case s.ch <- err:
+		case <-s.done:
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errlist
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Subscribe(t *testing.T) {
+	err1, err2, err3 := errors.New("err1"), errors.New("err2"), errors.New("err3")
+
+	t.Run("deliver", func(t *testing.T) {
+		var list List
+		list.Append(err1)
+
+		sub := list.Subscribe(4, Block)
+		list.Append(err2)
+		list.AppendUnique(err2)
+		list.Prepend(err3)
+		list.Append(nil)
+		sub.Close()
+
+		var have []error
+		for err := range sub.C {
+			have = append(have, err)
+		}
+		assert.Equal(t, []error{err2, err3}, have)
+		assert.Equal(t, []error{err3, err1, err2}, list.All())
+	})
+	t.Run("drop newest", func(t *testing.T) {
+		var list List
+		sub := list.Subscribe(1, DropNewest)
+		defer sub.Close()
+
+		list.Append(err1)
+		list.Append(err2)
+		assert.Same(t, err1, <-sub.C)
+		assert.Equal(t, uint64(1), sub.Dropped())
+	})
+	t.Run("drop oldest", func(t *testing.T) {
+		var list List
+		sub := list.Subscribe(0, DropOldest)
+		defer sub.Close()
+
+		list.Append(err1)
+		list.Append(err2)
+		assert.Same(t, err2, <-sub.C)
+		assert.Equal(t, uint64(1), sub.Dropped())
+	})
+	t.Run("close releases blocked", func(t *testing.T) {
+		var list List
+		// listeners are called in order, so the subscription receives the
+		// error after appending is closed
+		appending := make(chan struct{})
+		list.OnAppend(func(error) { close(appending) })
+		sub := list.Subscribe(0, Block)
+
+		done := make(chan struct{})
+		go func() {
+			list.Append(err1)
+			close(done)
+		}()
+
+		<-appending
+		assert.Equal(t, 1, list.Len())
+		select {
+		case <-done:
+			t.Fatal("Append should block until the subscription is closed")
+		default:
+		}
+
+		sub.Close()
+		<-done
+
+		_, ok := <-sub.C
+		assert.False(t, ok)
+		sub.Close()
+	})
+	t.Run("closed list", func(t *testing.T) {
+		var list List
+		sub := list.Subscribe(1, Block)
+		list.Close()
+		list.Close()
+
+		_, ok := <-sub.C
+		assert.False(t, ok)
+
+		sub = list.Subscribe(1, Block)
+		_, ok = <-sub.C
+		assert.False(t, ok)
+		assert.True(t, list.Append(err1))
+	})
+}
+
+func TestList_OnAppend(t *testing.T) {
+	var list List
+	var have []error
+	unregister := list.OnAppend(func(err error) {
+		// the list is unlocked, so it can be accessed
+		assert.Equal(t, len(have)+1, list.Len())
+		have = append(have, err)
+	})
+
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	list.Append(err1)
+	unregister()
+	list.Append(err2)
+
+	assert.Equal(t, []error{err1}, have)
+
+	list.Close()
+	list.OnAppend(func(error) { t.Fatal("should not be called") })()
+	list.Append(err2)
+}
+
+func TestList_Subscribe_concurrent(t *testing.T) {
+	var list List
+	sub := list.Subscribe(0, Block)
+
+	var received []error
+	done := make(chan struct{})
+	go func() {
+		for err := range sub.C {
+			received = append(received, err)
+		}
+		close(done)
+	}()
+
+	const n = 100
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			list.Append(errors.New("err"))
+		}()
+	}
+	wg.Wait()
+	list.Close()
+	<-done
+
+	assert.Len(t, received, n)
+	assert.Equal(t, n, list.Len())
+}
+
+func TestShardedList_Subscribe(t *testing.T) {
+	err1, err2, err3 := errors.New("err1"), errors.New("err2"), errors.New("err3")
+
+	list := NewSharded(4)
+	list.Append(err1)
+
+	sub := list.Subscribe(4, Block)
+	list.Append(err2)
+	list.AppendUnique(err2)
+	list.AppendUnique(err2)
+	list.Prepend(err3)
+	list.Append(nil)
+	list.Close()
+	list.Close()
+
+	var have []error
+	for err := range sub.C {
+		have = append(have, err)
+	}
+	assert.Equal(t, []error{err2, err2, err3}, have)
+	assert.Equal(t, []error{err3, err1, err2, err2}, list.All())
+
+	sub = list.Subscribe(1, Block)
+	_, ok := <-sub.C
+	assert.False(t, ok)
+}
+
+func TestShardedList_OnAppend(t *testing.T) {
+	var list ShardedList
+	var have []error
+	unregister := list.OnAppend(func(err error) {
+		// the shard is unlocked, so the list can be accessed
+		assert.Equal(t, len(have)+1, list.Len())
+		have = append(have, err)
+	})
+
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	list.Append(err1)
+	unregister()
+	list.Append(err2)
+
+	assert.Equal(t, []error{err1}, have)
+
+	list.Close()
+	list.OnAppend(func(error) { t.Fatal("should not be called") })()
+	list.Append(err2)
+}
@@ -43,6 +43,7 @@ func New(msg interface{}) error {
 		return nil
 	}
 
+	var parent Msg
 	switch v := msg.(type) {
 	case *commonError:
 		return v
@@ -50,14 +51,14 @@ func New(msg interface{}) error {
 		return v
 
 	case string:
-		return newCommonErr(Msg(v), true, 1)
+		parent = Msg(v)
 	case *string:
-		return newCommonErr(Msg(*v), true, 1)
+		parent = Msg(*v)
 
 	case Msg:
-		return newCommonErr(v, true, 1)
+		parent = v
 	case *Msg:
-		return newCommonErr(*v, true, 1)
+		parent = *v
 
 	case error:
 		panic(panicUseWithStackInstead)
@@ -65,40 +66,114 @@ func New(msg interface{}) error {
 	default:
 		panic(unsupportedType("errors.New", reflect.TypeOf(v).String()))
 	}
+
+	err := newCommonErr(parent, true, 1)
+	runHooks(err, 1)
+	return err
 }
 
 // Newf formats an error message according to a format specifier and provided
 // arguments.
 //
 // Deprecated: Use [Errorf] instead.
-func Newf(format string, args ...interface{}) error { return errorf(format, args) }
+func Newf(format string, args ...interface{}) error {
+	err := errorf(format, args)
+	runHooks(err, 1)
+	return err
+}
 
 // Errorf formats an error message according to a format specifier and provided
 // arguments with [fmt.Errorf], and creates a new error similar to [New].
 //
 //	err := errors.Errorf("my error %s", "message")
 //	err := errors.Errorf("my error: %w", cause)
-func Errorf(format string, args ...interface{}) error { return errorf(format, args) }
+func Errorf(format string, args ...interface{}) error {
+	err := errorf(format, args)
+	runHooks(err, 1)
+	return err
+}
 
 func errorf(format string, args []interface{}) error {
 	if len(args) == 0 {
 		return newCommonErr(Msg(format), true, 2)
 	}
 
-	err := fmt.Errorf(format, args...)
-	if w, ok := err.(interface{ Unwrap() []error }); ok {
-		me := newMultiErr(w.Unwrap(), 2)
-		me.msg = err.Error()
+	fm := newFormatMsg(format, args)
+	return newFormatErr(fm, fm, 2)
+}
+
+// newFormatErr creates a new error with msg as its message. The error(s)
+// wrapped by fm, using the %w verb, become the cause(s) of the error.
+func newFormatErr(msg error, fm *formatMsg, skipFrames uint) error {
+	//goland:noinspection GoTypeAssertionOnErrors
+	if w, ok := fm.error.(interface{ Unwrap() []error }); ok {
+		me := newMultiErr(w.Unwrap(), skipFrames+1)
+		me.msg = msg
 		return me
 	}
 
-	ce := newCommonErr(err, true, 2)
-	if w, ok := err.(xerrors.Wrapper); ok {
-		if cause := w.Unwrap(); cause != nil {
-			_ = withCause(ce, cause)
+	var cause error
+	//goland:noinspection GoTypeAssertionOnErrors
+	if w, ok := fm.error.(xerrors.Wrapper); ok {
+		cause = w.Unwrap()
+	}
+	return newWrapErr(msg, cause, skipFrames+1)
+}
+
+// formatMsg is the message of an error created with [Errorf] or [Wrapf]. It
+// keeps the format and arguments the message is created from.
+type formatMsg struct {
+	// error is the result of [fmt.Errorf] with all [Redacted] arguments
+	// replaced by their actual values.
+	error
+	format string
+	args   []interface{}
+	// redact indicates args contain at least one [Redacted] value.
+	redact bool
+	// nested indicates args contain at least one error, of which the message
+	// may contain [Redacted] values as well.
+	nested bool
+}
+
+func newFormatMsg(format string, args []interface{}) *formatMsg {
+	fm := &formatMsg{format: format, args: args}
+
+	full := args
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case Redacted:
+			if !fm.redact {
+				fm.redact = true
+				full = make([]interface{}, len(args))
+				copy(full, args)
+			}
+			full[i] = v.v
+		case error:
+			fm.nested = true
 		}
 	}
-	return ce
+
+	fm.error = fmt.Errorf(format, full...)
+	return fm
+}
+
+// Error returns the formatted message. Any [Redacted] arguments are replaced
+// with [RedactPlaceholder] when [RedactMessages] is true. Messages of errors
+// that are passed as arguments are rendered at the moment Error is called,
+// so they are redacted according to the current value of [RedactMessages].
+func (fm *formatMsg) Error() string {
+	if !RedactMessages {
+		return fm.unredacted()
+	}
+	if fm.redact || fm.nested {
+		return fmt.Errorf(fm.format, fm.args...).Error()
+	}
+	return fm.error.Error()
+}
+
+// GoString prints the error in basic Go syntax.
+func (fm *formatMsg) GoString() string {
+	return fmt.Sprintf("errors.formatMsg{format: %q, args: %#v}", fm.format, fm.args)
 }
 
 func (m Msg) Is(target error) bool {
@@ -140,15 +215,18 @@ type commonError struct {
 func newCommonErr(parent error, trace bool, skipFrames uint) *commonError {
 	ce := &commonError{error: parent}
 	if internal.TraceStack && trace {
-		ce.stack = newStackTrace(skipFrames + 1)
+		ce.stack = newStackTrace(skipFrames+1, nil)
 	}
 	return ce
 }
 
-func withCause(ce *commonError, cause error) *commonError {
-	ce.cause = cause
-	if internal.TraceStack && ce.stack != nil {
-		skipStackTrace(cause, ce.stack.Len())
+// newWrapErr creates a new [commonError] with cause as the next error in the
+// chain. Its stack trace shares the frames it has in common with the stack
+// trace of cause.
+func newWrapErr(parent, cause error, skipFrames uint) *commonError {
+	ce := &commonError{error: parent, cause: cause}
+	if internal.TraceStack {
+		ce.stack = newStackTrace(skipFrames+1, GetStackTrace(cause))
 	}
 	return ce
 }
@@ -161,10 +239,14 @@ func (ce *commonError) Unwrap() error { return ce.cause }
 
 func (ce *commonError) Is(target error) bool {
 	//goland:noinspection GoTypeAssertionOnErrors
-	if m, ok := ce.error.(Msg); ok {
+	switch m := ce.error.(type) {
+	case Msg:
+		return m.Is(target)
+	case *templateMsg:
 		return m.Is(target)
+	default:
+		return false
 	}
-	return false
 }
 
 func (ce *commonError) As(target interface{}) bool {
@@ -187,7 +269,7 @@ func (ce *commonError) Format(s fmt.State, v rune) {
 // the next error in the error chain, if any.
 func (ce *commonError) FormatError(p Printer) error {
 	PrintError(p, ce)
-	return ce.cause
+	return formatNext(ce.cause, ce.stack)
 }
 
 // GoString prints the error in basic Go syntax.
@@ -0,0 +1,160 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package errtest contains assertion helpers for testing errors, which are
+// aware of stack traces, error chains and additional error metadata like
+// status and exit codes. On failure, each helper reports the complete
+// formatted error tree, including stack trace details, so failing tests are
+// easier to debug.
+package errtest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-pogo/errors"
+)
+
+// AssertIs asserts that err matches target using [errors.Is].
+func AssertIs(tb testing.TB, err, target error) bool {
+	tb.Helper()
+	if errors.Is(err, target) {
+		return true
+	}
+	return fail(tb, err, "error chain does not contain target: %v", target)
+}
+
+// AssertAs asserts that err's chain contains an error of type T using
+// [errors.As]. It returns the found error when the assertion succeeds.
+func AssertAs[T any](tb testing.TB, err error) (T, bool) {
+	tb.Helper()
+	var target T
+	if errors.As(err, &target) {
+		return target, true
+	}
+	return target, fail(tb, err, "error chain does not contain an error of type %s",
+		reflect.TypeOf((*T)(nil)).Elem(),
+	)
+}
+
+// AssertChain asserts that the messages of the errors within err's chain are
+// equal to msgs. The messages are the same as the parts of err's formatted
+// output when printed with the %v verb.
+//
+//	err := errors.Wrap(errors.New("cause"), "whoops")
+//	errtest.AssertChain(t, err, "whoops", "cause")
+func AssertChain(tb testing.TB, err error, msgs ...string) bool {
+	tb.Helper()
+	have := ChainMessages(err)
+	if len(have) == len(msgs) {
+		equal := true
+		for i, msg := range msgs {
+			if have[i] != msg {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return true
+		}
+	}
+	return fail(tb, err, "error chain messages are not equal:\nexpected: %q\nactual  : %q", msgs, have)
+}
+
+// AssertStatusCode asserts that err's chain contains an [errors.StatusCoder]
+// with the expected status code.
+func AssertStatusCode(tb testing.TB, err error, want int) bool {
+	tb.Helper()
+	if have := errors.GetStatusCode(err); have != want {
+		return fail(tb, err, "status code is not equal:\nexpected: %d\nactual  : %d", want, have)
+	}
+	return true
+}
+
+// AssertExitCode asserts that err's chain contains an [errors.ExitCoder] with
+// the expected exit code.
+func AssertExitCode(tb testing.TB, err error, want int) bool {
+	tb.Helper()
+	if have := errors.GetExitCode(err); have != want {
+		return fail(tb, err, "exit code is not equal:\nexpected: %d\nactual  : %d", want, have)
+	}
+	return true
+}
+
+// AssertHasStack asserts that err's chain contains an [errors.StackTracer]
+// with a captured [errors.StackTrace]. Note that the assertion always fails
+// when stack tracing is disabled using the "notrace" build tag.
+func AssertHasStack(tb testing.TB, err error) bool {
+	tb.Helper()
+	var st errors.StackTracer
+	if errors.As(err, &st) && st.StackTrace() != nil {
+		return true
+	}
+	return fail(tb, err, "error chain does not contain a stack trace")
+}
+
+// AssertMultiLen asserts that err is an [errors.MultiError] which unwraps
+// into n errors.
+func AssertMultiLen(tb testing.TB, err error, n int) bool {
+	tb.Helper()
+	var multi errors.MultiError
+	if !errors.As(err, &multi) {
+		return fail(tb, err, "error chain does not contain a multi error")
+	}
+	if have := len(multi.Unwrap()); have != n {
+		return fail(tb, err, "multi error length is not equal:\nexpected: %d\nactual  : %d", n, have)
+	}
+	return true
+}
+
+// ChainMessages returns the messages of the errors within err's chain. These
+// are the same as the parts of err's formatted output when printed with the
+// %v verb.
+func ChainMessages(err error) []string {
+	var res []string
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		f, ok := err.(errors.Formatter)
+		if !ok {
+			res = append(res, err.Error())
+			break
+		}
+
+		var p printer
+		err = f.FormatError(&p)
+		res = append(res, p.String())
+	}
+	return res
+}
+
+// Sprint returns the complete formatted error tree of err, including stack
+// trace details.
+func Sprint(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", errors.WithFormatter(err))
+}
+
+func fail(tb testing.TB, err error, format string, args ...interface{}) bool {
+	tb.Helper()
+	tb.Errorf("%s\n\nerror tree:\n%s", fmt.Sprintf(format, args...), Sprint(err))
+	return false
+}
+
+// printer is an [errors.Printer] which collects the printed messages without
+// details.
+type printer struct{ strings.Builder }
+
+func (p *printer) Print(args ...interface{}) {
+	_, _ = fmt.Fprint(p, args...)
+}
+
+func (p *printer) Printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(p, format, args...)
+}
+
+func (*printer) Detail() bool { return false }
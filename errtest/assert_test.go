@@ -0,0 +1,150 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errtest
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/go-pogo/errors/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+// tbHelper is a [testing.TB] which records failures instead of failing the
+// actual test.
+type tbHelper struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (tb *tbHelper) Helper() {}
+
+func (tb *tbHelper) Errorf(format string, args ...interface{}) {
+	tb.failed = true
+	tb.msg = fmt.Sprintf(format, args...)
+}
+
+func TestAssertIs(t *testing.T) {
+	cause := stderrors.New("cause")
+	err := errors.Wrap(cause, "whoops")
+
+	var tb tbHelper
+	assert.True(t, AssertIs(&tb, err, cause))
+	assert.False(t, tb.failed)
+
+	assert.False(t, AssertIs(&tb, err, errors.Msg("other")))
+	assert.True(t, tb.failed)
+	assert.Contains(t, tb.msg, "whoops")
+}
+
+func TestAssertAs(t *testing.T) {
+	err := errors.WithExitCode(errors.New("some err"), 2)
+
+	var tb tbHelper
+	have, ok := AssertAs[errors.ExitCoder](&tb, err)
+	assert.True(t, ok)
+	assert.Same(t, err, have)
+	assert.False(t, tb.failed)
+
+	_, ok = AssertAs[errors.StatusCoder](&tb, err)
+	assert.False(t, ok)
+	assert.True(t, tb.failed)
+	assert.Contains(t, tb.msg, "errors.StatusCoder")
+}
+
+func TestAssertChain(t *testing.T) {
+	err := errors.Wrap(errors.WithStack(stderrors.New("cause")), "whoops")
+
+	var tb tbHelper
+	assert.True(t, AssertChain(&tb, err, "whoops", "cause"))
+	assert.False(t, tb.failed)
+
+	assert.False(t, AssertChain(&tb, err, "whoops"))
+	assert.True(t, tb.failed)
+	assert.False(t, AssertChain(&tb, err, "whoops", "other"))
+}
+
+func TestAssertStatusCode(t *testing.T) {
+	err := errors.WithStatusCode(errors.New("some err"), http.StatusNotFound)
+
+	var tb tbHelper
+	assert.True(t, AssertStatusCode(&tb, err, http.StatusNotFound))
+	assert.False(t, tb.failed)
+
+	assert.False(t, AssertStatusCode(&tb, err, http.StatusOK))
+	assert.True(t, tb.failed)
+}
+
+func TestAssertExitCode(t *testing.T) {
+	err := errors.WithExitCode(errors.New("some err"), 3)
+
+	var tb tbHelper
+	assert.True(t, AssertExitCode(&tb, err, 3))
+	assert.False(t, tb.failed)
+
+	assert.False(t, AssertExitCode(&tb, err, 1))
+	assert.True(t, tb.failed)
+}
+
+func TestAssertHasStack(t *testing.T) {
+	var tb tbHelper
+	assert.False(t, AssertHasStack(&tb, stderrors.New("some err")))
+	assert.True(t, tb.failed)
+
+	if !internal.TraceStack {
+		return
+	}
+
+	tb = tbHelper{}
+	assert.True(t, AssertHasStack(&tb, errors.New("some err")))
+	assert.False(t, tb.failed)
+}
+
+func TestAssertMultiLen(t *testing.T) {
+	err := errors.Join(errors.New("foo"), errors.New("bar"))
+
+	var tb tbHelper
+	assert.True(t, AssertMultiLen(&tb, err, 2))
+	assert.False(t, tb.failed)
+
+	assert.False(t, AssertMultiLen(&tb, err, 3))
+	assert.True(t, tb.failed)
+	assert.Contains(t, tb.msg, "[2/2] bar")
+
+	tb = tbHelper{}
+	assert.False(t, AssertMultiLen(&tb, errors.New("foo"), 1))
+	assert.True(t, tb.failed)
+}
+
+func TestChainMessages(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want []string
+	}{
+		"nil": {},
+		"std error": {
+			err:  stderrors.New("some err"),
+			want: []string{"some err"},
+		},
+		"std wrap": {
+			err:  fmt.Errorf("whoops: %w", stderrors.New("some err")),
+			want: []string{"whoops: some err"},
+		},
+		"wrap wrap": {
+			err:  errors.Wrap(errors.Wrap(errors.New("some err"), "failure"), "whoops"),
+			want: []string{"whoops", "failure", "some err"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ChainMessages(tc.err))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errtest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update is namespaced, so it does not conflict with an "update" flag that is
+// defined by a test package which imports errtest.
+var update = flag.Bool("errtest.update", false, "update errtest golden files")
+
+// GoldenDir is the directory golden files are read from and written to by
+// [AssertGolden].
+var GoldenDir = "testdata"
+
+// AssertGolden asserts that the formatted error tree of err, as returned by
+// [Sprint] and normalized using [DefaultNormalizer], is equal to the contents
+// of golden file name within [GoldenDir]. The ".golden" extension is added to
+// name. Run the tests with the -errtest.update flag to create or update the
+// golden files with the actual output.
+//
+//	go test -run TestMyError -errtest.update
+func AssertGolden(tb testing.TB, err error, name string) bool {
+	tb.Helper()
+
+	have := Normalize(Sprint(err))
+	file := filepath.Join(GoldenDir, name+".golden")
+
+	if *update {
+		if e := os.MkdirAll(GoldenDir, 0o755); e != nil {
+			tb.Fatalf("unable to create golden file directory: %v", e)
+		}
+		if e := os.WriteFile(file, []byte(have), 0o644); e != nil {
+			tb.Fatalf("unable to update golden file: %v", e)
+		}
+		return true
+	}
+
+	want, e := os.ReadFile(file)
+	if e != nil {
+		tb.Errorf("unable to read golden file: %v\n\nerror tree:\n%s", e, have)
+		return false
+	}
+	if string(want) != have {
+		tb.Errorf("error tree is not equal to golden file %s:\nexpected:\n%s\n\nactual:\n%s",
+			file, want, have,
+		)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !notrace
+
+package errtest
+
+import (
+	stderrors "errors"
+	"flag"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssertGolden(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		err := errors.Wrap(errors.WithStack(stderrors.New("some err")), "whoops")
+		AssertGolden(t, err, "wrap")
+	})
+	if *update {
+		return
+	}
+	t.Run("not equal", func(t *testing.T) {
+		var tb tbHelper
+		assert.False(t, AssertGolden(&tb, errors.New("other err"), "wrap"))
+		assert.True(t, tb.failed)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		var tb tbHelper
+		assert.False(t, AssertGolden(&tb, errors.New("some err"), "does-not-exist"))
+		assert.True(t, tb.failed)
+	})
+}
+
+func TestUpdateFlag(t *testing.T) {
+	assert.Nil(t, flag.Lookup("update"))
+	assert.NotNil(t, flag.Lookup("errtest.update"))
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errtest
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// DefaultNormalizer is the [Normalizer] used by [Normalize] and
+// [AssertGolden].
+var DefaultNormalizer = Normalizer{
+	StripLines:      true,
+	StripAddrs:      true,
+	CollapseRuntime: true,
+}
+
+// Normalizer normalizes formatted error output, containing stack traces, so it
+// can be compared between different systems and test runs. File paths within
+// stack frames are always made relative to the module's root directory.
+type Normalizer struct {
+	// Root is the absolute path of the directory file paths are made relative
+	// to. When empty, the directory containing the go.mod file of the current
+	// working directory is used.
+	Root string
+	// StripLines replaces the line numbers of stack frames with "?".
+	StripLines bool
+	// StripAddrs replaces hexadecimal addresses, like pointer values, with
+	// "0x?".
+	StripAddrs bool
+	// CollapseRuntime collapses consecutive stack frames of functions from the
+	// runtime and testing packages into a single "..." line. These frames
+	// differ depending on the goroutine an error is created in.
+	CollapseRuntime bool
+}
+
+var (
+	fileLineRegexp = regexp.MustCompile(`^(\s+)(\S+):(\d+)$`)
+	addrRegexp     = regexp.MustCompile(`0x[0-9a-fA-F]+`)
+)
+
+// Normalize normalizes s using [DefaultNormalizer].
+func Normalize(s string) string { return DefaultNormalizer.Normalize(s) }
+
+// Normalize returns a normalized version of s, which contains formatted error
+// output like the result of [Sprint].
+func (n Normalizer) Normalize(s string) string {
+	root := n.Root
+	if root == "" {
+		root = moduleRoot()
+	}
+	if root != "" {
+		root = strings.TrimSuffix(filepath.ToSlash(root), "/") + "/"
+	}
+	goroot := filepath.ToSlash(runtime.GOROOT())
+	if goroot != "" {
+		goroot = strings.TrimSuffix(goroot, "/") + "/src/"
+	}
+
+	lines := strings.Split(s, "\n")
+	res := make([]string, 0, len(lines))
+	var collapsed bool
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if i+1 < len(lines) {
+			if m := fileLineRegexp.FindStringSubmatch(lines[i+1]); m != nil {
+				// line is the function name of a stack frame, followed by
+				// its file and line number
+				if n.CollapseRuntime && isRuntimeFunc(strings.TrimSpace(line)) {
+					if !collapsed {
+						res = append(res, leadingSpace(line)+"...")
+						collapsed = true
+					}
+					i++
+					continue
+				}
+
+				collapsed = false
+				res = append(res, line, n.normalizeFileLine(m, root, goroot))
+				i++
+				continue
+			}
+		}
+
+		collapsed = false
+		res = append(res, line)
+	}
+
+	s = strings.Join(res, "\n")
+	if n.StripAddrs {
+		s = addrRegexp.ReplaceAllString(s, "0x?")
+	}
+	return s
+}
+
+func (n Normalizer) normalizeFileLine(m []string, root, goroot string) string {
+	file, line := filepath.ToSlash(m[2]), m[3]
+	switch {
+	case root != "" && strings.HasPrefix(file, root):
+		file = strings.TrimPrefix(file, root)
+	case goroot != "" && strings.HasPrefix(file, goroot):
+		file = "$GOROOT/" + strings.TrimPrefix(file, goroot)
+	default:
+		if i := strings.Index(file, "/pkg/mod/"); i >= 0 {
+			file = "$GOMODCACHE/" + file[i+len("/pkg/mod/"):]
+		}
+	}
+	if n.StripLines {
+		line = "?"
+	}
+	return m[1] + file + ":" + line
+}
+
+func isRuntimeFunc(fn string) bool {
+	return strings.HasPrefix(fn, "runtime.") || strings.HasPrefix(fn, "testing.")
+}
+
+func leadingSpace(s string) string {
+	return s[:len(s)-len(strings.TrimLeft(s, " \t"))]
+}
+
+var (
+	moduleRootOnce sync.Once
+	moduleRootDir  string
+)
+
+// moduleRoot returns the directory containing the go.mod file of the current
+// working directory, or an empty string if it cannot be found.
+func moduleRoot() string {
+	moduleRootOnce.Do(func() {
+		dir, err := os.Getwd()
+		if err != nil {
+			return
+		}
+		for {
+			if _, err = os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+				moduleRootDir = dir
+				return
+			}
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				return
+			}
+			dir = parent
+		}
+	})
+	return moduleRootDir
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizer_Normalize(t *testing.T) {
+	const input = `some err 0xc000123abc:
+    github.com/go-pogo/errors/errtest.TestSomething
+        /path/to/module/errtest/some_test.go:12
+    testing.tRunner
+        /goroot/src/testing/testing.go:1690
+    runtime.goexit
+        /goroot/src/runtime/asm_amd64.s:1700
+    example.com/pkg.Func
+        /home/user/go/pkg/mod/example.com/pkg@v1.0.0/pkg.go:3`
+
+	tests := map[string]struct {
+		normalizer Normalizer
+		want       string
+	}{
+		"paths only": {
+			normalizer: Normalizer{Root: "/path/to/module"},
+			want: `some err 0xc000123abc:
+    github.com/go-pogo/errors/errtest.TestSomething
+        errtest/some_test.go:12
+    testing.tRunner
+        /goroot/src/testing/testing.go:1690
+    runtime.goexit
+        /goroot/src/runtime/asm_amd64.s:1700
+    example.com/pkg.Func
+        $GOMODCACHE/example.com/pkg@v1.0.0/pkg.go:3`,
+		},
+		"all": {
+			normalizer: Normalizer{
+				Root:            "/path/to/module/",
+				StripLines:      true,
+				StripAddrs:      true,
+				CollapseRuntime: true,
+			},
+			want: `some err 0x?:
+    github.com/go-pogo/errors/errtest.TestSomething
+        errtest/some_test.go:?
+    ...
+    example.com/pkg.Func
+        $GOMODCACHE/example.com/pkg@v1.0.0/pkg.go:?`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.normalizer.Normalize(input))
+		})
+	}
+}
+
+func TestModuleRoot(t *testing.T) {
+	assert.FileExists(t, moduleRoot()+"/go.mod")
+}
@@ -81,3 +81,22 @@ func ExampleCatchPanic() {
 	// [1/2] first error;
 	// [2/2] panic: something bad happened
 }
+
+func ExampleCleanup() {
+	var cleanup Cleanup
+	cleanup.Add(func() error {
+		fmt.Println("close first resource")
+		return nil
+	})
+	cleanup.Add(func() error {
+		fmt.Println("close second resource")
+		return New("failed to close second resource")
+	})
+
+	err := cleanup.Run()
+	fmt.Println(err)
+	// Output:
+	// close second resource
+	// close first resource
+	// failed to close second resource
+}
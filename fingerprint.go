@@ -0,0 +1,56 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"hash/fnv"
+	"reflect"
+)
+
+// Fingerprint returns a hash which identifies err, so equal errors can be
+// found without comparing them with [Is]. It is calculated from the type and
+// message of each error in err's tree, including the errors of a
+// [MultiError]. [Embedder] errors, which only add extra context like a time
+// or stack trace to the error they embed, are skipped. As a result, errors
+// with the same message and cause(s) that are created at different places
+// have the same fingerprint. Errors created from a [Template] are identified
+// by the template instead of their message, so they have the same
+// fingerprint regardless of their arguments. Fingerprint returns 0 when err
+// is nil.
+func Fingerprint(err error) uint64 {
+	if err == nil {
+		return 0
+	}
+
+	h := fnv.New64a()
+	walkTree(err, false, func(e error) bool {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if _, ok := e.(Embedder); ok {
+			return true
+		}
+
+		_, _ = h.Write([]byte(reflect.TypeOf(e).String()))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(ownMessage(e)))
+		_, _ = h.Write([]byte{0})
+		return true
+	})
+	return h.Sum64()
+}
+
+// ownMessage returns the message of err that is used by [Fingerprint]. Errors
+// created from a [Template] use their template instead.
+func ownMessage(err error) string {
+	if tm := getTemplateMsg(err); tm != nil {
+		return string(tm.tmpl)
+	}
+	//goland:noinspection GoTypeAssertionOnErrors
+	if m, ok := err.(*multiErr); ok && m.msg == nil {
+		// the message is rendered from the errors within the multi error,
+		// which are walked separately
+		return ""
+	}
+	return err.Error()
+}
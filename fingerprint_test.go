@@ -0,0 +1,79 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprint(t *testing.T) {
+	assert.Equal(t, uint64(0), Fingerprint(nil))
+
+	err := New("some err")
+	tests := map[string]struct {
+		a, b  error
+		equal bool
+	}{
+		"same error": {
+			a: err, b: err,
+			equal: true,
+		},
+		"same message": {
+			a: New("some err"), b: New("some err"),
+			equal: true,
+		},
+		"different message": {
+			a: New("some err"), b: New("other err"),
+		},
+		"different type": {
+			a: New("some err"), b: stderrors.New("some err"),
+		},
+		"with time": {
+			a: err, b: WithTime(err, time.Now()),
+			equal: true,
+		},
+		"with stack": {
+			a: err, b: WithStack(err),
+			equal: true,
+		},
+		"wrapped": {
+			a: Wrap(err, "ctx"), b: Wrap(New("some err"), "ctx"),
+			equal: true,
+		},
+		"wrapped different cause": {
+			a: Wrap(stderrors.New("disk full"), "save failed"),
+			b: Wrap(stderrors.New("permission denied"), "save failed"),
+		},
+		"joined": {
+			a: Join(New("err1"), New("err2")), b: Join(New("err1"), New("err2")),
+			equal: true,
+		},
+		"joined different branch": {
+			a: WithStatusCode(Join(New("err1"), Wrap(New("a"), "err2")), 500),
+			b: WithStatusCode(Join(New("err1"), Wrap(New("b"), "err2")), 500),
+		},
+		"std joined different branch": {
+			a: stderrors.Join(New("err1"), Wrap(New("a"), "err2")),
+			b: stderrors.Join(New("err1"), Wrap(New("b"), "err2")),
+		},
+		"wrapped different cause type": {
+			a: fmt.Errorf("ctx: %w", err), b: fmt.Errorf("ctx: %w", stderrors.New("some err")),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.equal {
+				assert.Equal(t, Fingerprint(tc.a), Fingerprint(tc.b))
+			} else {
+				assert.NotEqual(t, Fingerprint(tc.a), Fingerprint(tc.b))
+			}
+		})
+	}
+}
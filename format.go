@@ -58,11 +58,24 @@ func PrintError(p Printer, err error) {
 		return
 	}
 
-	p.Print(err.Error())
+	msg := err.Error()
+	p.Print(msg)
 	if !p.Detail() {
 		return
 	}
+	printUnredacted(p, err, msg)
 	if stack := GetStackTrace(err); stack != nil {
 		stack.Format(p)
 	}
 }
+
+// printUnredacted prints the unredacted message of err when [PrintUnredacted]
+// is enabled and the message differs from the already printed msg.
+func printUnredacted(p Printer, err error, msg string) {
+	if !PrintUnredacted {
+		return
+	}
+	if full := Unredacted(err); full != msg {
+		p.Printf("unredacted: %s\n", full)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// DefaultReporter is called by [Go] with the resulting error when no handler
+// is provided. By default, it prints the error, including its stack trace
+// details, to stderr.
+var DefaultReporter = func(err error) {
+	_, _ = fmt.Fprintf(os.Stderr, "%+v\n", err)
+}
+
+const panicGoNilFn = "errors.Go: fn must not be nil"
+
+// Go calls fn in a new goroutine. Any panic within fn is recovered and
+// converted to an error, like [CatchPanic] does. When fn returns a non-nil
+// error or panics, time information is added to the error using [WithTime]
+// and the error is passed to handler. If handler is nil, [DefaultReporter] is
+// used instead.
+//
+//	errors.Go(func() error {
+//		return doSomething()
+//	}, func(err error) {
+//		log.Printf("%+v", err)
+//	})
+func Go(fn func() error, handler func(error)) {
+	if fn == nil {
+		panic(panicGoNilFn)
+	}
+	if handler == nil {
+		handler = DefaultReporter
+	}
+
+	go func() {
+		var err error
+		defer func() {
+			if err != nil {
+				handler(WithTime(err, time.Now()))
+			}
+		}()
+		defer CatchPanic(&err)
+
+		err = fn()
+	}()
+}
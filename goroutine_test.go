@@ -0,0 +1,57 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGo(t *testing.T) {
+	t.Run("panic on nil fn", func(t *testing.T) {
+		assert.PanicsWithValue(t, panicGoNilFn, func() {
+			Go(nil, nil)
+		})
+	})
+
+	tests := map[string]struct {
+		fn      func() error
+		wantMsg string
+	}{
+		"error": {
+			fn:      func() error { return stderrors.New("some err") },
+			wantMsg: "some err",
+		},
+		"panic": {
+			fn:      func() error { panic("paniek!") },
+			wantMsg: "panic: paniek!",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := make(chan error, 1)
+			Go(tc.fn, func(err error) { ch <- err })
+
+			have := <-ch
+			assert.Equal(t, tc.wantMsg, have.Error())
+
+			_, hasTime := GetTime(have)
+			assert.True(t, hasTime)
+		})
+	}
+
+	t.Run("default reporter", func(t *testing.T) {
+		ch := make(chan error, 1)
+		defer func(r func(error)) { DefaultReporter = r }(DefaultReporter)
+		DefaultReporter = func(err error) { ch <- err }
+
+		want := stderrors.New("some err")
+		Go(func() error { return want }, nil)
+		assert.ErrorIs(t, <-ch, want)
+	})
+}
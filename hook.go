@@ -0,0 +1,134 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Hook is a function which is called each time an error is created using
+// [New], [NewCtx], [Errorf], [Wrap], [WrapCtx], [Wrapf], [WithStack], [Join]
+// or [JoinWith]. It receives the created error and the [Frame] of the function
+// that called the constructor. Hooks are called synchronously and should
+// therefore be fast and safe for concurrent use.
+type Hook func(err error, caller Frame)
+
+type hookEntry struct{ fn Hook }
+
+var (
+	hooksMut sync.Mutex
+	// hooks contains the registered hooks. It is nil when no hooks are
+	// registered, so running them is as cheap as possible.
+	hooks atomic.Pointer[[]*hookEntry]
+)
+
+const panicRegisterNilHook = "errors.RegisterHook: hook must not be nil"
+
+// RegisterHook registers hook, so it is called each time an error is created.
+// It returns a function which unregisters the hook.
+//
+//	unregister := errors.RegisterHook(func(err error, caller errors.Frame) {
+//		counter.Add(1)
+//	})
+//	defer unregister()
+func RegisterHook(hook Hook) (unregister func()) {
+	if hook == nil {
+		panic(panicRegisterNilHook)
+	}
+
+	entry := &hookEntry{fn: hook}
+
+	hooksMut.Lock()
+	defer hooksMut.Unlock()
+
+	var list []*hookEntry
+	if cur := hooks.Load(); cur != nil {
+		list = append(list, *cur...)
+	}
+	list = append(list, entry)
+	hooks.Store(&list)
+
+	var once sync.Once
+	return func() { once.Do(func() { unregisterHook(entry) }) }
+}
+
+func unregisterHook(entry *hookEntry) {
+	hooksMut.Lock()
+	defer hooksMut.Unlock()
+
+	cur := hooks.Load()
+	if cur == nil {
+		return
+	}
+
+	list := make([]*hookEntry, 0, len(*cur))
+	for _, e := range *cur {
+		if e != entry {
+			list = append(list, e)
+		}
+	}
+	if len(list) == 0 {
+		hooks.Store(nil)
+	} else {
+		hooks.Store(&list)
+	}
+}
+
+// runHooks calls all registered hooks with err and the caller skipFrames
+// above the function calling runHooks.
+func runHooks(err error, skipFrames int) {
+	if list := hooks.Load(); list != nil {
+		callHooks(*list, err, skipFrames+1)
+	}
+}
+
+func callHooks(list []*hookEntry, err error, skipFrames int) {
+	var pc [1]uintptr
+	runtime.Callers(skipFrames+2, pc[:])
+
+	// pc is the return address, subtract one so the frame points to the call
+	// instruction itself and not to whatever (inlined) code follows it
+	caller := Frame(pc[0] - 1)
+	for _, e := range list {
+		e.fn(err, caller)
+	}
+}
+
+// MessageKey returns a key which identifies the message of err, and can be
+// used to group similar errors. For errors created with [New], [Wrap] or from
+// a [Code], this is their [Msg]. For errors created with [Errorf] or [Wrapf]
+// this is the format string, so the key does not contain any of the
+// (sensitive) arguments. For any other error, err.Error() is returned.
+func MessageKey(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	switch e := Unembed(err).(type) {
+	case Msg:
+		return string(e)
+	case codeErrorer:
+		return messageKey(e.codeErr().error)
+	case *commonError:
+		return messageKey(e.error)
+	case *multiErr:
+		if e.msg != nil {
+			return messageKey(e.msg)
+		}
+		return e.opts.header()
+	}
+	return err.Error()
+}
+
+func messageKey(parent error) string {
+	//goland:noinspection GoTypeAssertionOnErrors
+	if fm := asFormatMsg(parent); fm != nil {
+		return fm.format
+	}
+	return parent.Error()
+}
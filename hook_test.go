@@ -0,0 +1,108 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterHook(t *testing.T) {
+	t.Run("panic on nil", func(t *testing.T) {
+		assert.PanicsWithValue(t, panicRegisterNilHook, func() {
+			RegisterHook(nil)
+		})
+	})
+
+	type call struct {
+		err  error
+		file string
+		fn   string
+	}
+
+	var calls []call
+	unregister := RegisterHook(func(err error, caller Frame) {
+		file, _ := caller.FileLine()
+		calls = append(calls, call{err: err, file: file, fn: caller.Func().Name()})
+	})
+
+	var cat Catalog
+	code := cat.Define(Code{ID: "E1", Msg: "code msg"})
+
+	cause := stderrors.New("cause")
+	tests := map[string]func() error{
+		"New":       func() error { return New("some err") },
+		"Newf":      func() error { return Newf("some %s", "err") },
+		"Errorf":    func() error { return Errorf("some %s", "err") },
+		"Wrap":      func() error { return Wrap(cause, "some err") },
+		"Wrapf":     func() error { return Wrapf(cause, "some %s", "err") },
+		"WithStack": func() error { return WithStack(cause) },
+		"Join":      func() error { return Join(cause, cause) },
+		"Code.New":  func() error { return code.New() },
+		"Code.Wrap": func() error { return code.Wrap(cause) },
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls = calls[:0]
+			err := fn()
+
+			_, file, _, _ := runtime.Caller(0)
+			assert.Len(t, calls, 1)
+			assert.Same(t, err, calls[0].err)
+			assert.Equal(t, file, calls[0].file)
+			assert.Contains(t, calls[0].fn, "errors.TestRegisterHook.func")
+		})
+	}
+
+	t.Run("Join without new multi error", func(t *testing.T) {
+		calls = calls[:0]
+		_ = Join()
+		_ = Join(nil)
+		_ = Join(cause)
+		_ = JoinWith(MultiOptions{Dedupe: true}, cause, cause)
+		assert.Empty(t, calls)
+	})
+
+	t.Run("unregister", func(t *testing.T) {
+		calls = calls[:0]
+		unregister()
+		unregister()
+
+		_ = New("some err")
+		assert.Empty(t, calls)
+		assert.Nil(t, hooks.Load())
+	})
+}
+
+func TestMessageKey(t *testing.T) {
+	var cat Catalog
+	code := cat.Define(Code{ID: "E1", Msg: "code msg"})
+
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"nil":       {},
+		"Msg":       {err: Msg("some msg"), want: "some msg"},
+		"New":       {err: New("some msg"), want: "some msg"},
+		"Errorf":    {err: Errorf("user %d not found", 1), want: "user %d not found"},
+		"Wrapf":     {err: Wrapf(Msg("cause"), "user %d", 2), want: "user %d"},
+		"WithStack": {err: WithStack(fmt.Errorf("user %d", 3)), want: "user 3"},
+		"code":      {err: code.New(), want: "code msg"},
+		"Join":      {err: Join(New("a"), New("b")), want: multiErrHeader},
+		"multi":     {err: Errorf("%w, %w", New("a"), New("b")), want: "%w, %w"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MessageKey(tc.err))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package errors
+
+import "iter"
+
+// Chain returns an iterator over err and each error in its chain, as
+// returned by [Unwrap]. Unlike [UnwrapAll], it does not allocate a slice and
+// ranging over it can be stopped early.
+//
+//	for e := range errors.Chain(err) {
+//		// ...
+//	}
+func Chain(err error) iter.Seq[error] {
+	return func(yield func(error) bool) { walkChain(err, yield) }
+}
+
+// Tree returns an iterator over err and all errors it wraps, including the
+// errors within a [MultiError]. The errors are yielded depth-first, in
+// pre-order.
+func Tree(err error) iter.Seq[error] {
+	return func(yield func(error) bool) { walkTree(err, false, yield) }
+}
+
+// Leaves returns an iterator over the errors within err's tree, see [Tree],
+// that do not wrap any other errors.
+func Leaves(err error) iter.Seq[error] {
+	return func(yield func(error) bool) { walkTree(err, true, yield) }
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collect(seq func(func(error) bool)) []error {
+	var res []error
+	for err := range seq {
+		res = append(res, err)
+	}
+	return res
+}
+
+func TestChain(t *testing.T) {
+	assert.Nil(t, collect(Chain(nil)))
+
+	root := stderrors.New("root")
+	wrap := Wrap(root, "wrap")
+	outer := Wrap(wrap, "outer")
+	assert.Equal(t, []error{outer, wrap, root}, collect(Chain(outer)))
+	assert.Equal(t, UnwrapAll(outer), collect(Chain(outer)))
+
+	t.Run("break", func(t *testing.T) {
+		var n int
+		for range Chain(outer) {
+			n++
+			break
+		}
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestTree(t *testing.T) {
+	assert.Nil(t, collect(Tree(nil)))
+
+	err1 := stderrors.New("err1")
+	err2 := stderrors.New("err2")
+	err3 := stderrors.New("err3")
+	wrap := Wrap(err2, "wrap")
+	inner := Join(wrap, err3)
+	multi := Join(err1, inner)
+	outer := Wrap(multi, "outer")
+
+	assert.Equal(t,
+		[]error{outer, multi, err1, inner, wrap, err2, err3},
+		collect(Tree(outer)),
+	)
+	assert.Equal(t, []error{err1, err2, err3}, collect(Leaves(outer)))
+
+	t.Run("break", func(t *testing.T) {
+		var res []error
+		for err := range Leaves(outer) {
+			res = append(res, err)
+			if err == err2 {
+				break
+			}
+		}
+		assert.Equal(t, []error{err1, err2}, res)
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// LocalizedError interfaces provide a localized version of the error's own
+// message, which excludes the messages of any wrapped errors.
+type LocalizedError interface {
+	error
+	// LocalizedError returns the error's own message in language lang. It
+	// returns false when no localized message is available.
+	LocalizedError(lang string) (string, bool)
+}
+
+var translations = struct {
+	sync.RWMutex
+	langs map[string]map[string]string
+}{}
+
+// RegisterTranslations registers translations for language lang, e.g. "nl" or
+// "de-AT". The keys of the translations map are either [Msg] values or the
+// format strings used with [Errorf] and [Wrapf], its values are their
+// translations. Translations of format strings must contain the same verbs as
+// the original, and may use explicit argument indexes to change the order of
+// the arguments.
+//
+//	errors.RegisterTranslations("nl", map[string]string{
+//		"user not found":          "gebruiker niet gevonden",
+//		"file %s not found in %s": "bestand %[1]s niet gevonden in %[2]s",
+//	})
+//
+// Existing translations are overwritten.
+func RegisterTranslations(lang string, msgs map[string]string) {
+	lang = normalizeLang(lang)
+
+	translations.Lock()
+	defer translations.Unlock()
+
+	if translations.langs == nil {
+		translations.langs = make(map[string]map[string]string, 4)
+	}
+	m := translations.langs[lang]
+	if m == nil {
+		m = make(map[string]string, len(msgs))
+		translations.langs[lang] = m
+	}
+	for k, v := range msgs {
+		m[k] = v
+	}
+}
+
+// Translate returns the registered translation of msg in language lang. When
+// no translation for a regional language, e.g. "nl-BE", is registered, the
+// translation of its base language "nl" is used. It returns msg and false
+// when no translation is found.
+func Translate(lang, msg string) (string, bool) {
+	lang = normalizeLang(lang)
+
+	translations.RLock()
+	defer translations.RUnlock()
+
+	for lang != "" {
+		if tr, ok := translations.langs[lang][msg]; ok {
+			return tr, true
+		}
+
+		i := strings.LastIndexByte(lang, '-')
+		if i < 0 {
+			break
+		}
+		lang = lang[:i]
+	}
+	return msg, false
+}
+
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+}
+
+// Localize renders the complete error chain of err in language lang, similar
+// to formatting err with the %v verb. The message of each error in the chain
+// is localized using its [LocalizedError] implementation or the translations
+// registered with [RegisterTranslations]. It falls back to the original
+// message when no translation is available.
+func Localize(err error, lang string) string {
+	var buf strings.Builder
+	for err != nil {
+		if buf.Len() != 0 {
+			buf.WriteString(": ")
+		}
+		buf.WriteString(localizeMsg(err, lang))
+
+		// continue with the next error in the chain like xerrors.FormatError
+		// does when printing
+		//goland:noinspection GoTypeAssertionOnErrors
+		f, ok := err.(Formatter)
+		if !ok {
+			break
+		}
+		err = f.FormatError(discardPrinter{})
+	}
+	return buf.String()
+}
+
+// localizeMsg returns the localized version of err's own message.
+func localizeMsg(err error, lang string) string {
+	for {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if le, ok := err.(LocalizedError); ok {
+			if msg, ok := le.LocalizedError(lang); ok {
+				return msg
+			}
+		}
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		e, ok := err.(Embedder)
+		if !ok {
+			break
+		}
+		err = e.Unembed()
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	switch e := err.(type) {
+	case codeErrorer:
+		return localizeParent(e.codeErr().error, lang)
+	case *commonError:
+		return localizeParent(e.error, lang)
+	case *multiErr:
+		if e.msg != nil {
+			return localizeParent(e.msg, lang)
+		}
+		header, _ := Translate(lang, e.opts.header())
+		more, _ := Translate(lang, multiErrMore)
+		return e.render(header, more, func(err error) string {
+			return Localize(err, lang)
+		})
+	default:
+		msg, _ := Translate(lang, err.Error())
+		return msg
+	}
+}
+
+// localizeParent localizes the message of the error which is embedded in a
+// [commonError] or used as message of a [multiErr].
+func localizeParent(parent error, lang string) string {
+	//goland:noinspection GoTypeAssertionOnErrors
+	if fm := asFormatMsg(parent); fm != nil {
+		return fm.localize(lang)
+	}
+	msg, _ := Translate(lang, parent.Error())
+	return msg
+}
+
+// localize returns the message in language lang, formatted using the
+// translation of the original format. Errors passed as arguments are
+// localized as well.
+func (fm *formatMsg) localize(lang string) string {
+	format, ok := Translate(lang, fm.format)
+	if !ok && !hasErrorArgs(fm.args) {
+		return fm.Error()
+	}
+
+	args := make([]interface{}, len(fm.args))
+	for i, arg := range fm.args {
+		switch v := arg.(type) {
+		case Redacted:
+			if RedactMessages {
+				args[i] = v
+			} else {
+				args[i] = v.v
+			}
+		case error:
+			args[i] = localizedArg{err: v, lang: lang}
+		default:
+			args[i] = arg
+		}
+	}
+	return fmt.Errorf(format, args...).Error()
+}
+
+func hasErrorArgs(args []interface{}) bool {
+	for _, arg := range args {
+		if _, ok := arg.(error); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// localizedArg is an error argument of a [formatMsg] which prints the
+// localized version of the error.
+type localizedArg struct {
+	err  error
+	lang string
+}
+
+func (a localizedArg) Error() string { return Localize(a.err, a.lang) }
+
+// discardPrinter is a [Printer] which discards everything it prints.
+type discardPrinter struct{}
+
+func (discardPrinter) Print(...interface{})          {}
+func (discardPrinter) Printf(string, ...interface{}) {}
+func (discardPrinter) Detail() bool                  { return false }
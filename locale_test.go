@@ -0,0 +1,152 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type localizedTestError struct{}
+
+func (localizedTestError) Error() string { return "custom error" }
+
+func (localizedTestError) LocalizedError(lang string) (string, bool) {
+	if strings.HasPrefix(lang, "nl") {
+		return "eigen fout", true
+	}
+	return "", false
+}
+
+func TestTranslate(t *testing.T) {
+	RegisterTranslations("xx", map[string]string{"hello": "xx hello"})
+	RegisterTranslations("xx_YY", map[string]string{"bye": "xx-yy bye"})
+
+	tests := map[string]struct {
+		lang, msg, want string
+		ok              bool
+	}{
+		"exact":        {lang: "xx", msg: "hello", want: "xx hello", ok: true},
+		"region":       {lang: "xx-YY", msg: "bye", want: "xx-yy bye", ok: true},
+		"base":         {lang: "xx-YY", msg: "hello", want: "xx hello", ok: true},
+		"unknown msg":  {lang: "xx", msg: "bye", want: "bye"},
+		"unknown lang": {lang: "zz", msg: "hello", want: "hello"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			have, ok := Translate(tc.lang, tc.msg)
+			assert.Equal(t, tc.want, have)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	const (
+		errNotFound Msg = "user not found"
+		errLoading  Msg = "loading failed"
+	)
+
+	RegisterTranslations("nl", map[string]string{
+		string(errNotFound):   "gebruiker niet gevonden",
+		string(errLoading):    "laden mislukt",
+		"user %d in group %s": "gebruiker %[1]d in groep %[2]s",
+		"file %s: %w":         "bestand %s: %w",
+		"email %s is invalid": "e-mailadres %s is ongeldig",
+		multiErrHeader:        "meerdere fouten opgetreden:",
+		"some std error":      "een standaard fout",
+	})
+	RegisterTranslations("de", map[string]string{
+		string(errNotFound):   "Benutzer nicht gefunden",
+		"user %d in group %s": "Benutzer %[1]d in Gruppe %[2]s",
+	})
+
+	var cat Catalog
+	code := cat.Define(Code{ID: "USR-001", Msg: errNotFound})
+
+	tests := map[string]struct {
+		err          error
+		wantNL       string
+		wantDE       string
+		wantFallback string
+	}{
+		"Msg": {
+			err:          errNotFound,
+			wantNL:       "gebruiker niet gevonden",
+			wantDE:       "Benutzer nicht gefunden",
+			wantFallback: "user not found",
+		},
+		"New": {
+			err:          New(errNotFound),
+			wantNL:       "gebruiker niet gevonden",
+			wantDE:       "Benutzer nicht gefunden",
+			wantFallback: "user not found",
+		},
+		"Wrap": {
+			err:          Wrap(WithStack(New(errNotFound)), errLoading),
+			wantNL:       "laden mislukt: gebruiker niet gevonden",
+			wantDE:       "loading failed: Benutzer nicht gefunden",
+			wantFallback: "loading failed: user not found",
+		},
+		"Errorf": {
+			err:          Errorf("user %d in group %s", 42, "admins"),
+			wantNL:       "gebruiker 42 in groep admins",
+			wantDE:       "Benutzer 42 in Gruppe admins",
+			wantFallback: "user 42 in group admins",
+		},
+		"Errorf with cause": {
+			err:          Errorf("file %s: %w", "users.json", New(errNotFound)),
+			wantNL:       "bestand users.json: gebruiker niet gevonden: gebruiker niet gevonden",
+			wantDE:       "file users.json: Benutzer nicht gefunden: Benutzer nicht gefunden",
+			wantFallback: "file users.json: user not found: user not found",
+		},
+		"Wrapf with redacted arg": {
+			err:          Wrapf(errNotFound, "email %s is invalid", Redact("john@example.com")),
+			wantNL:       "e-mailadres [redacted] is ongeldig: gebruiker niet gevonden",
+			wantDE:       "email [redacted] is invalid: Benutzer nicht gefunden",
+			wantFallback: "email [redacted] is invalid: user not found",
+		},
+		"code": {
+			err:          code.New(),
+			wantNL:       "gebruiker niet gevonden",
+			wantDE:       "Benutzer nicht gefunden",
+			wantFallback: "user not found",
+		},
+		"std error": {
+			err:          stderrors.New("some std error"),
+			wantNL:       "een standaard fout",
+			wantDE:       "some std error",
+			wantFallback: "some std error",
+		},
+		"LocalizedError": {
+			err:          WithExitCode(localizedTestError{}, 1),
+			wantNL:       "eigen fout",
+			wantDE:       "custom error",
+			wantFallback: "custom error",
+		},
+		"multi": {
+			err:          Join(New(errNotFound), Errorf("user %d in group %s", 1, "x")),
+			wantNL:       "meerdere fouten opgetreden:\n[1/2] gebruiker niet gevonden;\n[2/2] gebruiker 1 in groep x",
+			wantDE:       "multiple errors occurred:\n[1/2] Benutzer nicht gefunden;\n[2/2] Benutzer 1 in Gruppe x",
+			wantFallback: "multiple errors occurred:\n[1/2] user not found;\n[2/2] user 1 in group x",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.wantNL, Localize(tc.err, "nl"), "nl")
+			assert.Equal(t, tc.wantNL, Localize(tc.err, "nl-BE"), "nl-BE")
+			assert.Equal(t, tc.wantDE, Localize(tc.err, "de"), "de")
+			assert.Equal(t, tc.wantFallback, Localize(tc.err, "fr"), "fr")
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, "", Localize(nil, "nl"))
+	})
+}
@@ -6,6 +6,8 @@ package errors
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/go-pogo/errors/internal"
@@ -36,23 +38,93 @@ func Filter(errors []error) []error {
 	return errors[:n]
 }
 
+// MultiOptions control how errors are combined into a [MultiError] by
+// [Join], [JoinWith], [Append] and [AppendInto].
+type MultiOptions struct {
+	// Flatten collapses nested multi errors, created with [Join], [Append] or
+	// [AppendInto], into a single level. See [Flatten].
+	Flatten bool
+	// Dedupe skips errors that are duplicates of an error that is already
+	// within the multi error. See [Dedupe].
+	Dedupe bool
+
+	// Header is the first line of the multi error's message. Defaults to
+	// "multiple errors occurred:" when empty.
+	Header string
+	// Separator is written between the messages of the errors. Defaults to
+	// ";\n" when empty. When Separator does not contain a newline, the
+	// header is followed by a space instead of a newline, so the complete
+	// message is rendered on a single line.
+	Separator string
+	// Limit is the maximum number of error messages that are rendered. The
+	// remaining errors are summarized with "and K more". A value of 0 means
+	// no limit.
+	Limit int
+	// Prefix returns the prefix of the message of the error with index i
+	// within the multi error that contains n errors. Defaults to "[i/n] ",
+	// where i starts at 1, when nil.
+	Prefix func(i, n int) string
+}
+
+// DefaultMultiOptions are the [MultiOptions] used by [Join], [Append] and
+// [AppendInto]. Changes only affect multi errors that are created afterwards.
+//
+//	errors.DefaultMultiOptions.Separator = "; "
+var DefaultMultiOptions MultiOptions
+
 // Join returns a [MultiError] when more than one non-nil errors are provided.
 // It returns a single error when only one error is passed, and nil if no
-// non-nil errors are provided.
+// non-nil errors are provided. It uses [DefaultMultiOptions] to combine the
+// errors.
 func Join(errs ...error) error {
+	err, created := join(DefaultMultiOptions, errs, 1)
+	if created {
+		runHooks(err, 1)
+	}
+	return err
+}
+
+// JoinWith is like [Join] but uses opts instead of [DefaultMultiOptions].
+//
+//	err := errors.JoinWith(errors.MultiOptions{Flatten: true}, err1, err2)
+func JoinWith(opts MultiOptions, errs ...error) error {
+	err, created := join(opts, errs, 1)
+	if created {
+		runHooks(err, 1)
+	}
+	return err
+}
+
+// join combines errs into a new [multiErr] according to opts. It returns
+// false when no [multiErr] is created, because errs contains less than two
+// (non-duplicate) non-nil errors.
+func join(opts MultiOptions, errs []error, skipFrames uint) (error, bool) {
 	if len(errs) == 0 {
-		return nil
+		return nil, false
+	}
+
+	if opts.Flatten || opts.Dedupe {
+		res := make([]error, 0, len(errs))
+		for _, err := range errs {
+			if err != nil {
+				res = opts.appendTo(res, err)
+			}
+		}
+		errs = res
+	} else {
+		errs = Filter(errs)
 	}
 
-	errs = Filter(errs)
 	switch len(errs) {
 	case 0:
-		return nil
+		return nil, false
 	case 1:
-		return errs[0]
+		return errs[0], false
 	}
 
-	return newMultiErr(errs, 2)
+	m := newMultiErr(errs, skipFrames+1)
+	m.opts = opts
+	return m, true
 }
 
 // Append creates a [MultiError] from two non-nil errors. If left is already a
@@ -71,7 +143,8 @@ func Append(left, right error) error {
 		m.append(right)
 		return m
 	}
-	return newMultiErr([]error{left, right}, 1)
+	err, _ := join(DefaultMultiOptions, []error{left, right}, 1)
+	return err
 }
 
 const (
@@ -109,14 +182,276 @@ func AppendInto(dest *error, errs ...error) (errored bool) {
 		} else if m, ok := (*dest).(*multiErr); ok {
 			multi = m
 			multi.append(err)
-		} else {
-			multi = newMultiErr([]error{*dest, err}, 1)
+		} else if errs := DefaultMultiOptions.appendTo([]error{*dest}, err); len(errs) > 1 {
+			multi = newMultiErr(errs, 1)
+			multi.opts = DefaultMultiOptions
 			*dest = multi
 		}
 	}
 	return multi != nil
 }
 
+// Flatten collapses the nested multi errors within err, which are created
+// with [Join], [Append] or [AppendInto], into a single level. The stack trace
+// of the outermost multi error is preserved. It returns err as is when it is
+// not a multi error or does not contain any nested multi errors.
+//
+//	errors.Flatten(errors.Join(errors.Join(err1, err2), err3))
+//	// is similar to errors.Join(err1, err2, err3)
+func Flatten(err error) error {
+	//goland:noinspection GoTypeAssertionOnErrors
+	m, ok := err.(*multiErr)
+	if !ok || m.msg != nil {
+		return err
+	}
+
+	nested := false
+	for _, e := range m.errs {
+		if isFlattenable(e) {
+			nested = true
+			break
+		}
+	}
+	if !nested {
+		return err
+	}
+
+	return m.rebuild(MultiOptions{Flatten: true}.appendTo(nil, m))
+}
+
+// Dedupe removes errors from the multi error err which are duplicates of an
+// earlier error within it. Two errors are considered duplicates when the
+// errors in their trees have the same types and full, unredacted messages, or
+// when they are either both or both not a root cause, see [IsCause], and the
+// latter matches the former using [Is]. Separately created errors with the
+// same message, e.g. New(ErrX), are therefore duplicates of each other, while
+// errors created from the same [Template] with different arguments are not. The stack trace of the multi error is
+// preserved. It returns err as is when it is not a multi error or does not
+// contain any duplicates.
+func Dedupe(err error) error {
+	//goland:noinspection GoTypeAssertionOnErrors
+	m, ok := err.(*multiErr)
+	if !ok {
+		return err
+	}
+
+	errs := make([]error, 0, len(m.errs))
+	for _, e := range m.errs {
+		if !containsDuplicate(errs, e) {
+			errs = append(errs, e)
+		}
+	}
+	if len(errs) == len(m.errs) {
+		return err
+	}
+	if len(errs) == 1 && m.msg == nil {
+		return errs[0]
+	}
+	return m.rebuild(errs)
+}
+
+// Partition splits the errors within the multi error err into the errors for
+// which pred returns true and the rest. Both are rebuilt into multi errors
+// that keep the stack trace of err, or into a single error or nil, when
+// applicable. When err is not a multi error created with [Join], [Append] or
+// [AppendInto], it is either returned as matched or as rest. This also applies
+// to a multi error that is wrapped or decorated, e.g. using [WithStatusCode],
+// and to multi errors of other packages, like the one returned by the
+// standard library's errors.Join, because their errors cannot be rebuilt
+// without losing the wrapping error.
+//
+//	retry, fatal := errors.Partition(err, isRetryable)
+func Partition(err error, pred func(error) bool) (matched, rest error) {
+	if err == nil {
+		return nil, nil
+	}
+	if !isFlattenable(err) {
+		if pred(err) {
+			return err, nil
+		}
+		return nil, err
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	m := err.(*multiErr)
+	var match, other []error
+	for _, e := range m.errs {
+		if pred(e) {
+			match = append(match, e)
+		} else {
+			other = append(other, e)
+		}
+	}
+	return m.rebuildPart(match), m.rebuildPart(other)
+}
+
+// FilterErr returns the errors within the multi error err for which pred
+// returns true. See [Partition] for details.
+func FilterErr(err error, pred func(error) bool) error {
+	matched, _ := Partition(err, pred)
+	return matched
+}
+
+// MapErr replaces each error within the multi error err with the result of
+// fn. Errors for which fn returns nil are removed. The result is rebuilt into
+// a multi error that keeps the stack trace of err, or into a single error or
+// nil, when applicable. When err is not a multi error created with [Join],
+// [Append] or [AppendInto], the result of fn(err) is returned, see
+// [Partition].
+//
+//	err = errors.MapErr(err, func(err error) error {
+//		return errors.WithStatusCode(err, http.StatusBadGateway)
+//	})
+func MapErr(err error, fn func(error) error) error {
+	if err == nil {
+		return nil
+	}
+	if !isFlattenable(err) {
+		return fn(err)
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	m := err.(*multiErr)
+	errs := make([]error, 0, len(m.errs))
+	for _, e := range m.errs {
+		if e = fn(e); e != nil {
+			errs = append(errs, e)
+		}
+	}
+	return m.rebuildPart(errs)
+}
+
+// Count returns the number of branches within err's tree for which pred
+// returns true. It walks through the complete tree of err, including all
+// (wrapped) multi errors, also those that are not created by this package. For
+// each branch, pred is called for the errors in its chain that are not a multi
+// error, until it returns true, so each branch is counted at most once. Errors
+// that wrap a multi error are only checked when none of the multi error's
+// branches are counted.
+//
+//	n := errors.Count(err, func(err error) bool {
+//		return errors.GetStatusCode(err) >= 500
+//	})
+func Count(err error, pred func(error) bool) int {
+	var chain []error
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if m, ok := err.(MultiError); ok {
+			var n int
+			for _, e := range m.Unwrap() {
+				n += Count(e, pred)
+			}
+			if n != 0 {
+				return n
+			}
+			break
+		}
+
+		chain = append(chain, err)
+		err = Unwrap(err)
+	}
+
+	for _, e := range chain {
+		if pred(e) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// rebuildPart returns nil, the single error within errs or a rebuilt
+// [multiErr] when errs contains multiple errors. It returns m itself when
+// errs contains all of its errors.
+func (m *multiErr) rebuildPart(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	if len(errs) == len(m.errs) {
+		same := true
+		for i, err := range errs {
+			if err != m.errs[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return m
+		}
+	}
+	return m.rebuild(errs)
+}
+
+// appendTo appends err to errs according to the [MultiOptions].
+func (opts MultiOptions) appendTo(errs []error, err error) []error {
+	if opts.Flatten && isFlattenable(err) {
+		//goland:noinspection GoTypeAssertionOnErrors
+		for _, e := range err.(*multiErr).errs {
+			errs = opts.appendTo(errs, e)
+		}
+		return errs
+	}
+	if opts.Dedupe && containsDuplicate(errs, err) {
+		return errs
+	}
+	return append(errs, err)
+}
+
+// isFlattenable indicates if err is a multi error without its own message.
+func isFlattenable(err error) bool {
+	//goland:noinspection GoTypeAssertionOnErrors
+	m, ok := err.(*multiErr)
+	return ok && m.msg == nil
+}
+
+// containsDuplicate indicates if errs contains a duplicate of err.
+func containsDuplicate(errs []error, err error) bool {
+	if len(errs) == 0 {
+		return false
+	}
+
+	isCause := IsCause(err)
+	var key string
+	for _, e := range errs {
+		if e == err || (IsCause(e) == isCause && Is(err, e)) {
+			return true
+		}
+		if key == "" {
+			key = duplicateKey(err)
+		}
+		if duplicateKey(e) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicateKey returns a key which identifies err by the type and full,
+// unredacted message of each error in its tree. Unlike [Fingerprint], errors
+// created from the same [Template] with different arguments have a different
+// key. [Embedder] errors are skipped.
+func duplicateKey(err error) string {
+	var b strings.Builder
+	walkTree(err, false, func(e error) bool {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if _, ok := e.(Embedder); ok {
+			return true
+		}
+
+		b.WriteString(reflect.TypeOf(e).String())
+		b.WriteByte(0)
+		//goland:noinspection GoTypeAssertionOnErrors
+		if m, ok := e.(*multiErr); !ok || m.msg != nil {
+			b.WriteString(Unredacted(e))
+		}
+		b.WriteByte(0)
+		return true
+	})
+	return b.String()
+}
+
 // AppendFunc appends the non-nil error result of fn to dest using
 // [AppendInto].
 func AppendFunc(dest *error, fn func() error) {
@@ -132,33 +467,43 @@ func AppendFunc(dest *error, fn func() error) {
 // multiErr is an error which unwraps into multiple underlying errors.
 type multiErr struct {
 	stack *StackTrace
-	msg   string
+	msg   error
 	errs  []error
+	opts  MultiOptions
 }
 
 func newMultiErr(errs []error, skipFrames uint) *multiErr {
 	m := &multiErr{errs: errs}
-	if !internal.TraceStack {
-		return m
-	}
-
-	m.stack = newStackTrace(skipFrames + 1)
-	skip := m.stack.Len()
-	for _, err := range m.errs {
-		skipStackTrace(err, skip)
+	if internal.TraceStack {
+		m.stack = newStackTrace(skipFrames+1, nil)
 	}
 	return m
 }
 
 func (m *multiErr) append(err error) {
-	if internal.TraceStack {
-		skipStackTrace(err, m.stack.Len())
+	m.errs = m.opts.appendTo(m.errs, err)
+}
+
+// rebuild returns a new [multiErr] with the same message, stack trace and
+// options as m, which contains errs.
+func (m *multiErr) rebuild(errs []error) *multiErr {
+	return &multiErr{
+		stack: m.stack,
+		msg:   m.msg,
+		errs:  errs,
+		opts:  m.opts,
 	}
-	m.errs = append(m.errs, err)
 }
 
 func (m *multiErr) StackTrace() *StackTrace { return m.stack }
 
+// Is reports whether the [multiErr] itself matches target, which is only the
+// case when it is created from a [Template] that matches target.
+func (m *multiErr) Is(target error) bool {
+	tm := getTemplateMsg(m)
+	return tm != nil && tm.Is(target)
+}
+
 // Unwrap returns the errors within the [multiErr].
 func (m *multiErr) Unwrap() []error { return m.errs }
 
@@ -174,17 +519,20 @@ func (m *multiErr) Format(s fmt.State, v rune) { xerrors.FormatError(m, s, v) }
 
 // FormatError prints a summary of the encountered errors to p.
 func (m *multiErr) FormatError(p Printer) error {
-	p.Print(m.Error())
+	msg := m.Error()
+	p.Print(msg)
 	if !p.Detail() {
 		return nil
 	}
 
+	printUnredacted(p, m, msg)
+
 	m.stack.Format(p)
 	p.Print("\n")
 
 	l := len(m.errs)
 	for i, err := range m.errs {
-		p.Printf("[%d/%d] %+v\n", i+1, l, err)
+		p.Printf("%s%+v\n", m.opts.prefix(i, l), formatNext(err, m.stack))
 		//goland:noinspection GoTypeAssertionOnErrors
 		if _, ok := err.(StackTracer); ok {
 			p.Print("\n")
@@ -193,20 +541,63 @@ func (m *multiErr) FormatError(p Printer) error {
 	return nil
 }
 
+const (
+	multiErrHeader    = "multiple errors occurred:"
+	multiErrSeparator = ";\n"
+	multiErrMore      = "and %d more"
+)
+
 func (m *multiErr) Error() string {
-	if m.msg != "" {
-		return m.msg
+	if m.msg != nil {
+		return m.msg.Error()
+	}
+	return m.render(m.opts.header(), multiErrMore, errorMsg)
+}
+
+// render returns a summary of the errors within the [multiErr], starting with
+// header and using msg to get the message of each error. The number of errors
+// that exceed [MultiOptions.Limit] is formatted using more.
+func (m *multiErr) render(header, more string, msg func(error) string) string {
+	sep := m.opts.Separator
+	if sep == "" {
+		sep = multiErrSeparator
 	}
 
 	var buf strings.Builder
-	buf.WriteString("multiple errors occurred:")
+	buf.WriteString(header)
+	if strings.ContainsRune(sep, '\n') {
+		buf.WriteByte('\n')
+	} else {
+		buf.WriteByte(' ')
+	}
 
 	l := len(m.errs)
 	for i, e := range m.errs {
-		_, _ = fmt.Fprintf(&buf, "\n[%d/%d] %s", i+1, l, e.Error())
-		if i < l-1 {
-			buf.WriteRune(';')
+		if i != 0 {
+			buf.WriteString(sep)
 		}
+		if m.opts.Limit > 0 && i == m.opts.Limit {
+			_, _ = fmt.Fprintf(&buf, more, l-i)
+			break
+		}
+		buf.WriteString(m.opts.prefix(i, l))
+		buf.WriteString(msg(e))
 	}
 	return buf.String()
 }
+
+func (opts MultiOptions) header() string {
+	if opts.Header == "" {
+		return multiErrHeader
+	}
+	return opts.Header
+}
+
+func (opts MultiOptions) prefix(i, n int) string {
+	if opts.Prefix == nil {
+		return "[" + strconv.Itoa(i+1) + "/" + strconv.Itoa(n) + "] "
+	}
+	return opts.Prefix(i, n)
+}
+
+func errorMsg(err error) string { return err.Error() }
@@ -132,6 +132,187 @@ func TestJoin(t *testing.T) {
 	})
 }
 
+func TestJoinWith(t *testing.T) {
+	err1 := stderrors.New("err1")
+	err2 := stderrors.New("err2")
+	err3 := stderrors.New("err3")
+
+	t.Run("flatten", func(t *testing.T) {
+		//goland:noinspection GoTypeAssertionOnErrors
+		multi := JoinWith(MultiOptions{Flatten: true}, Join(err1, err2), err3).(*multiErr)
+		assert.Exactly(t, []error{err1, err2, err3}, multi.Unwrap())
+	})
+	t.Run("dedupe", func(t *testing.T) {
+		//goland:noinspection GoTypeAssertionOnErrors
+		multi := JoinWith(MultiOptions{Dedupe: true}, err1, err2, err1, nil, err2).(*multiErr)
+		assert.Exactly(t, []error{err1, err2}, multi.Unwrap())
+	})
+	t.Run("dedupe single", func(t *testing.T) {
+		assert.Same(t, err1, JoinWith(MultiOptions{Dedupe: true}, err1, err1))
+	})
+	t.Run("flatten and dedupe", func(t *testing.T) {
+		opts := MultiOptions{Flatten: true, Dedupe: true}
+		//goland:noinspection GoTypeAssertionOnErrors
+		multi := JoinWith(opts, Join(err1, err2), Join(err2, err3)).(*multiErr)
+		assert.Exactly(t, []error{err1, err2, err3}, multi.Unwrap())
+
+		multi.append(Join(err3, err1))
+		assert.Exactly(t, []error{err1, err2, err3}, multi.Unwrap())
+	})
+	t.Run("default options", func(t *testing.T) {
+		DefaultMultiOptions = MultiOptions{Flatten: true}
+		defer func() { DefaultMultiOptions = MultiOptions{} }()
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		multi := Append(Join(err1, err2), Join(err3, err1)).(*multiErr)
+		assert.Exactly(t, []error{err1, err2, err3, err1}, multi.Unwrap())
+
+		var have error = err1
+		AppendInto(&have, Join(err2, err3))
+		//goland:noinspection GoTypeAssertionOnErrors
+		assert.Exactly(t, []error{err1, err2, err3}, have.(MultiError).Unwrap())
+	})
+	t.Run("default options dedupe", func(t *testing.T) {
+		DefaultMultiOptions = MultiOptions{Dedupe: true}
+		defer func() { DefaultMultiOptions = MultiOptions{} }()
+
+		var have error = err1
+		assert.False(t, AppendInto(&have, err1))
+		assert.Same(t, err1, have)
+	})
+}
+
+func TestMultiOptions_render(t *testing.T) {
+	errs := []error{
+		stderrors.New("err1"),
+		stderrors.New("err2"),
+		stderrors.New("err3"),
+	}
+
+	tests := map[string]struct {
+		opts MultiOptions
+		want string
+	}{
+		"default": {
+			want: "multiple errors occurred:\n[1/3] err1;\n[2/3] err2;\n[3/3] err3",
+		},
+		"header": {
+			opts: MultiOptions{Header: "validation failed:"},
+			want: "validation failed:\n[1/3] err1;\n[2/3] err2;\n[3/3] err3",
+		},
+		"single line": {
+			opts: MultiOptions{Separator: "; "},
+			want: "multiple errors occurred: [1/3] err1; [2/3] err2; [3/3] err3",
+		},
+		"limit": {
+			opts: MultiOptions{Limit: 1},
+			want: "multiple errors occurred:\n[1/3] err1;\nand 2 more",
+		},
+		"limit not reached": {
+			opts: MultiOptions{Limit: 3},
+			want: "multiple errors occurred:\n[1/3] err1;\n[2/3] err2;\n[3/3] err3",
+		},
+		"prefix": {
+			opts: MultiOptions{
+				Header:    "errors:",
+				Separator: ", ",
+				Limit:     2,
+				Prefix:    func(i, _ int) string { return fmt.Sprintf("#%d ", i) },
+			},
+			want: "errors: #0 err1, #1 err2, and 1 more",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := JoinWith(tc.opts, errs...)
+			assert.Equal(t, tc.want, err.Error())
+			if tc.opts.Limit == 0 {
+				assert.Contains(t, fmt.Sprintf("%+v", err), tc.opts.prefix(2, 3)+"err3")
+			}
+		})
+	}
+
+	t.Run("default options", func(t *testing.T) {
+		DefaultMultiOptions = MultiOptions{Separator: " | "}
+		defer func() { DefaultMultiOptions = MultiOptions{} }()
+
+		err := Join(errs[0], errs[1])
+		assert.Equal(t, "multiple errors occurred: [1/2] err1 | [2/2] err2", err.Error())
+		assert.Equal(t, "multiple errors occurred: [1/3] err1 | [2/3] err2 | [3/3] err3", Append(err, errs[2]).Error())
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	err1 := stderrors.New("err1")
+	err2 := stderrors.New("err2")
+	err3 := stderrors.New("err3")
+
+	t.Run("not multi", func(t *testing.T) {
+		assert.Same(t, err1, Flatten(err1))
+		assert.Nil(t, Flatten(nil))
+	})
+	t.Run("not nested", func(t *testing.T) {
+		multi := Join(err1, err2)
+		assert.Same(t, multi, Flatten(multi))
+	})
+	t.Run("nested", func(t *testing.T) {
+		inner := Errorf("custom %w and %w", err2, err3)
+		multi := Join(Join(err1, Join(err2, err3)), inner)
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		have := Flatten(multi).(*multiErr)
+		assert.Exactly(t, []error{err1, err2, err3, inner}, have.Unwrap())
+		assert.Same(t, GetStackTrace(multi), have.StackTrace())
+		assert.Equal(t, multi.Error()[:len(multiErrHeader)], have.Error()[:len(multiErrHeader)])
+	})
+}
+
+func TestDedupe(t *testing.T) {
+	err1 := stderrors.New("err1")
+	err2 := stderrors.New("err2")
+
+	t.Run("not multi", func(t *testing.T) {
+		assert.Same(t, err1, Dedupe(err1))
+	})
+	t.Run("without duplicates", func(t *testing.T) {
+		multi := Join(err1, err2)
+		assert.Same(t, multi, Dedupe(multi))
+	})
+	t.Run("duplicates", func(t *testing.T) {
+		multi := Join(err1, err2, err1, err2)
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		have := Dedupe(multi).(*multiErr)
+		assert.Exactly(t, []error{err1, err2}, have.Unwrap())
+		assert.Same(t, GetStackTrace(multi), have.StackTrace())
+	})
+	t.Run("single", func(t *testing.T) {
+		assert.Same(t, err1, Dedupe(Join(err1, err1)))
+	})
+	t.Run("wrapped is no duplicate", func(t *testing.T) {
+		multi := Join(err1, Wrap(err1, "wrapped"))
+		assert.Same(t, multi, Dedupe(multi))
+	})
+	t.Run("separately created", func(t *testing.T) {
+		const msg Msg = "some err"
+		first := New(msg)
+		assert.Same(t, first, Dedupe(Join(first, New(msg), New(msg))))
+	})
+	t.Run("same template", func(t *testing.T) {
+		const tmpl Template = "some %s"
+		multi := Join(tmpl.New("a"), tmpl.New("b"))
+		assert.Same(t, multi, Dedupe(multi))
+	})
+	t.Run("redacted", func(t *testing.T) {
+		multi := Join(
+			Errorf("user %s", Redact("alice")),
+			Errorf("user %s", Redact("bob")),
+		)
+		assert.Same(t, multi, Dedupe(multi))
+	})
+}
+
 func TestAppend(t *testing.T) {
 	t.Run("left nil", func(t *testing.T) {
 		want := New("some err")
@@ -201,7 +382,7 @@ func TestAppendInto(t *testing.T) {
 		assert.Exactly(t, errs, multi.Unwrap())
 
 		if internal.TraceStack {
-			assert.Equal(t, len(multi.stack.frames), 1)
+			assert.Equal(t, multi.stack.Len(), uint(1))
 
 			_, file, line, _ := runtime.Caller(0)
 			// line must point to the last AppendInto call a couple of lines above
@@ -229,3 +410,132 @@ func TestAppendFunc(t *testing.T) {
 		assert.Same(t, want, have)
 	})
 }
+
+func TestPartition(t *testing.T) {
+	err1 := stderrors.New("err1")
+	err2 := WithStatusCode(stderrors.New("err2"), 404)
+	err3 := WithStatusCode(stderrors.New("err3"), 500)
+	is4xx := func(err error) bool {
+		code := GetStatusCode(err)
+		return code >= 400 && code < 500
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		matched, rest := Partition(nil, is4xx)
+		assert.Nil(t, matched)
+		assert.Nil(t, rest)
+	})
+	t.Run("single", func(t *testing.T) {
+		matched, rest := Partition(err2, is4xx)
+		assert.Same(t, err2, matched)
+		assert.Nil(t, rest)
+
+		matched, rest = Partition(err1, is4xx)
+		assert.Nil(t, matched)
+		assert.Same(t, err1, rest)
+	})
+	t.Run("multi", func(t *testing.T) {
+		multi := Join(err1, err2, err3, WithStatusCode(stderrors.New("err4"), 400))
+		matched, rest := Partition(multi, is4xx)
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		m := matched.(*multiErr)
+		assert.Equal(t, []error{err2, multi.(*multiErr).errs[3]}, m.Unwrap())
+		assert.Same(t, GetStackTrace(multi), m.StackTrace())
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		assert.Equal(t, []error{err1, err3}, rest.(*multiErr).Unwrap())
+	})
+	t.Run("all matched", func(t *testing.T) {
+		multi := Join(err1, err3)
+		matched, rest := Partition(multi, func(error) bool { return true })
+		assert.Same(t, multi, matched)
+		assert.Nil(t, rest)
+	})
+	t.Run("single matched", func(t *testing.T) {
+		matched, rest := Partition(Join(err1, err2), is4xx)
+		assert.Same(t, err2, matched)
+		assert.Same(t, err1, rest)
+	})
+}
+
+func TestFilterErr(t *testing.T) {
+	err1 := stderrors.New("err1")
+	err2 := stderrors.New("err2")
+	sentinel := Msg("sentinel")
+
+	assert.Nil(t, FilterErr(Join(err1, err2), func(err error) bool { return Is(err, sentinel) }))
+
+	wrapped := Wrap(sentinel, "wrapped")
+	assert.Same(t, wrapped, FilterErr(Join(err1, wrapped, err2), func(err error) bool {
+		return Is(err, sentinel)
+	}))
+}
+
+func TestMapErr(t *testing.T) {
+	err1 := stderrors.New("err1")
+	err2 := stderrors.New("err2")
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, MapErr(nil, func(err error) error { return err }))
+	})
+	t.Run("single", func(t *testing.T) {
+		have := MapErr(err1, func(err error) error { return Wrap(err, "wrapped") })
+		assert.Equal(t, "wrapped: err1", fmt.Sprintf("%v", have))
+	})
+	t.Run("multi", func(t *testing.T) {
+		multi := Join(err1, err2, nil, stderrors.New("err3"))
+		have := MapErr(multi, func(err error) error {
+			if err == err2 {
+				return nil
+			}
+			return WithExitCode(err, 2)
+		})
+
+		//goland:noinspection GoTypeAssertionOnErrors
+		m := have.(*multiErr)
+		assert.Len(t, m.Unwrap(), 2)
+		assert.ErrorIs(t, m.Unwrap()[0], err1)
+		assert.Equal(t, 2, GetExitCode(m.Unwrap()[1]))
+		assert.Same(t, GetStackTrace(multi), m.StackTrace())
+	})
+}
+
+func TestCount(t *testing.T) {
+	sentinel := Msg("sentinel")
+	isSentinel := func(err error) bool { return Is(err, sentinel) }
+
+	assert.Equal(t, 0, Count(nil, isSentinel))
+	assert.Equal(t, 1, Count(sentinel, isSentinel))
+	assert.Equal(t, 0, Count(stderrors.New("err"), isSentinel))
+
+	err := Join(
+		New(sentinel),
+		Join(stderrors.New("err"), Wrap(sentinel, "wrapped")),
+		Errorf("%w and %w", sentinel, stderrors.New("other")),
+	)
+	assert.Equal(t, 3, Count(err, isSentinel))
+	assert.Equal(t, 5, Count(err, func(error) bool { return true }))
+
+	t.Run("foreign and wrapped multi errors", func(t *testing.T) {
+		assert.Equal(t, 2, Count(stderrors.Join(sentinel, New(sentinel)), isSentinel))
+		assert.Equal(t, 2, Count(WithStatusCode(Join(sentinel, New(sentinel)), 500), isSentinel))
+		assert.Equal(t, 2, Count(Wrap(stderrors.Join(sentinel, stderrors.New("err")), "wrapped"), func(error) bool { return true }))
+	})
+	t.Run("wrapping errors", func(t *testing.T) {
+		err := Join(WithStatusCode(New("a"), 404), New("b"))
+		assert.Equal(t, 1, Count(err, func(err error) bool {
+			return GetStatusCode(err) >= 400
+		}))
+
+		errX := Msg("x")
+		err = Join(Wrap(New("io"), errX), New("other"), Wrap(New("io"), errX))
+		assert.Equal(t, 2, Count(err, func(err error) bool { return Is(err, errX) }))
+	})
+	t.Run("wrapped multi error", func(t *testing.T) {
+		err := WithStatusCode(Join(New("a"), New("b")), 404)
+		assert.Equal(t, 1, Count(err, func(err error) bool {
+			return GetStatusCode(err) >= 400
+		}))
+	})
+}
@@ -26,3 +26,8 @@ func TestGetStackTrace(t *testing.T) {
 		})
 	}
 }
+
+func TestStackTraceEnabled(t *testing.T) {
+	EnableStackTrace()
+	assert.False(t, StackTraceEnabled())
+}
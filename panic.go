@@ -16,6 +16,26 @@ func WrapPanic(prefix string) {
 	}
 }
 
+// WrapPanicErr recovers from a panic and panics again with an error that wraps
+// the recovered value with msg. Argument msg can be either a string or [Msg].
+// Unlike [WrapPanic], the original panic value is kept as the error's cause,
+// and the stack trace of the panicking sequence is recorded. This allows
+// [CatchPanic] to recover the complete error, including a possible error
+// which was originally passed to panic, and still match it with [Is] and [As].
+//
+//	defer errors.WrapPanicErr("something went wrong")
+//
+// Use [WrapPanicErr] directly with defer, just like [CatchPanic]. When msg is
+// nil, the original panic value is not wrapped and is used to panic again.
+func WrapPanicErr(msg interface{}) {
+	if r := recover(); r != nil {
+		if msg == nil {
+			panic(r)
+		}
+		panic(newWrapErr(toMsg("errors.WrapPanicErr", msg), &panicError{v: r}, 1))
+	}
+}
+
 // Must panics when any of the given args is a non-nil error.
 // Its message is the error message of the first encountered error.
 func Must(args ...interface{}) {
@@ -34,6 +54,13 @@ func Must(args ...interface{}) {
 //	defer func(){ CatchPanic(&err }()
 func CatchPanic(dest *error) {
 	if r := recover(); r != nil {
+		// r is already a wrapped panic, see WrapPanicErr
+		//goland:noinspection GoTypeAssertionOnErrors
+		if err, ok := r.(error); ok && isWrappedPanic(err) {
+			AppendInto(dest, err)
+			return
+		}
+
 		AppendInto(dest, newCommonErr(&panicError{v: r}, false, 1))
 		if st := GetStackTrace(*dest); st != nil {
 			st.Skip = 1
@@ -43,6 +70,11 @@ func CatchPanic(dest *error) {
 
 type panicError struct{ v interface{} }
 
+func isWrappedPanic(err error) bool {
+	var pe *panicError
+	return As(err, &pe)
+}
+
 func (p *panicError) Unwrap() error {
 	if e, ok := p.v.(error); ok {
 		return e
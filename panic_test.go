@@ -36,6 +36,83 @@ func TestWrapPanic(t *testing.T) {
 	})
 }
 
+func TestWrapPanicErr(t *testing.T) {
+	t.Run("without panic", func(t *testing.T) {
+		defer func() {
+			assert.Nil(t, recover())
+		}()
+
+		defer WrapPanicErr("wrapped")
+	})
+
+	t.Run("with panic", func(t *testing.T) {
+		defer func() {
+			have, ok := recover().(error)
+			assert.True(t, ok)
+			assert.ErrorIs(t, have, Msg("wrapped"))
+			assert.Equal(t, "wrapped: panic: panic!", fmt.Sprintf("%v", have))
+
+			var pe *panicError
+			assert.ErrorAs(t, have, &pe)
+			assert.Equal(t, "panic!", pe.v)
+
+			if internal.TraceStack {
+				assert.Contains(t, GetStackTrace(have).String(), "panicOnSomething")
+			}
+		}()
+
+		defer WrapPanicErr("wrapped")
+		panicOnSomething()
+	})
+
+	t.Run("nil msg", func(t *testing.T) {
+		defer func() {
+			assert.Equal(t, "panic!", recover())
+		}()
+
+		defer WrapPanicErr(nil)
+		panicOnSomething()
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		cause := stderrors.New("original error")
+		defer func() {
+			//goland:noinspection GoTypeAssertionOnErrors
+			have := recover().(error)
+			assert.ErrorIs(t, have, Msg("wrapped"))
+			assert.ErrorIs(t, have, cause)
+		}()
+
+		defer WrapPanicErr(Msg("wrapped"))
+		panic(cause)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		assert.PanicsWithValue(t,
+			unsupportedType("errors.WrapPanicErr", "int"),
+			func() {
+				defer WrapPanicErr(10)
+				panic("panic!")
+			},
+		)
+	})
+
+	t.Run("catch", func(t *testing.T) {
+		cause := stderrors.New("original error")
+
+		var have error
+		func() {
+			defer CatchPanic(&have)
+			defer WrapPanicErr("wrapped")
+			panic(cause)
+		}()
+
+		assert.ErrorIs(t, have, Msg("wrapped"))
+		assert.ErrorIs(t, have, cause)
+		assert.Equal(t, "wrapped", have.Error())
+	})
+}
+
 func TestMust(t *testing.T) {
 	t.Run("nil error", func(t *testing.T) {
 		defer func() {
@@ -0,0 +1,61 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConcurrentFormat formats the same errors from many goroutines, while
+// they are wrapped by other goroutines. Run with -race to detect data races.
+func TestConcurrentFormat(t *testing.T) {
+	cause := func() error { return New("cause") }()
+	errs := map[string]error{
+		"New":       cause,
+		"Wrap":      Wrap(cause, "wrap"),
+		"Errorf":    Errorf("errorf: %w", cause),
+		"WithStack": WithStack(stderrors.New("std")),
+		"WithTime":  WithTime(New("time"), time.Now()),
+		"Join":      Join(cause, New("other")),
+	}
+
+	for name, err := range errs {
+		t.Run(name, func(t *testing.T) {
+			// wrapping must not change how the error itself is formatted
+			want := fmt.Sprintf("%+v", err)
+
+			const n = 50
+			res := make([]string, n)
+
+			var wg sync.WaitGroup
+			wg.Add(n)
+			for i := 0; i < n; i++ {
+				go func(i int) {
+					defer wg.Done()
+					if i%5 == 0 {
+						_ = Wrap(err, "concurrent wrap")
+					}
+
+					st := GetStackTrace(err)
+					_ = st.Frames()
+					_ = st.CallersFrames()
+					_ = st.String()
+					res[i] = fmt.Sprintf("%+v", err)
+				}(i)
+			}
+			wg.Wait()
+
+			for _, have := range res {
+				assert.Equal(t, want, have)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"fmt"
+	"io"
+)
+
+var (
+	// RedactPlaceholder is printed instead of the actual value of a
+	// [Redacted] argument.
+	RedactPlaceholder = "[redacted]"
+
+	// RedactMessages indicates if the Error method of errors created with
+	// [Errorf] or [Wrapf] returns the redacted form of the message, in which
+	// all [Redacted] arguments are replaced with [RedactPlaceholder]. It is
+	// true by default.
+	RedactMessages = true
+
+	// PrintUnredacted indicates if the full, unredacted message is printed as
+	// additional detail when formatting an error with the %+v verb. It is
+	// false by default and should only be enabled explicitly, e.g. when
+	// debugging.
+	PrintUnredacted = false
+)
+
+// Redacted is a sensitive value, which is created using [Redact].
+type Redacted struct{ v interface{} }
+
+// Redact marks v as a sensitive value. Use it to wrap arguments of [Errorf]
+// and [Wrapf] that contain user data, like email addresses or tokens, which
+// should not end up in logs or responses.
+//
+//	err := errors.Errorf("invalid email %s", errors.Redact(email))
+//	err.Error() // invalid email [redacted]
+//
+// The error keeps both the redacted and full message. Use [Unredacted] to get
+// the full message. Arguments used with the %w verb cannot be redacted.
+func Redact(v interface{}) Redacted { return Redacted{v: v} }
+
+// Value returns the actual sensitive value.
+func (r Redacted) Value() interface{} { return r.v }
+
+// Format writes [RedactPlaceholder] to s regardless of verb v.
+func (r Redacted) Format(s fmt.State, _ rune) {
+	_, _ = io.WriteString(s, RedactPlaceholder)
+}
+
+// String returns [RedactPlaceholder].
+func (r Redacted) String() string { return RedactPlaceholder }
+
+// GoString returns [RedactPlaceholder].
+func (r Redacted) GoString() string { return RedactPlaceholder }
+
+// Unredacted returns the full message of err, including the actual values of
+// any [Redacted] arguments, regardless of [RedactMessages]. For errors that
+// are not created with redacted arguments, it returns the same result as
+// err.Error(). It returns an empty string when err is nil.
+func Unredacted(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	switch e := Unembed(err).(type) {
+	case *commonError:
+		if fm := asFormatMsg(e.error); fm != nil {
+			return fm.unredacted()
+		}
+	case *multiErr:
+		if fm := asFormatMsg(e.msg); fm != nil {
+			return fm.unredacted()
+		}
+	case *formatMsg:
+		return e.unredacted()
+	case *templateMsg:
+		return e.unredacted()
+	}
+	return err.Error()
+}
+
+// unredacted returns the full message, including the actual values of any
+// [Redacted] arguments and the full messages of any error arguments.
+func (fm *formatMsg) unredacted() string {
+	if !fm.nested {
+		return fm.error.Error()
+	}
+
+	args := make([]interface{}, len(fm.args))
+	for i, arg := range fm.args {
+		switch v := arg.(type) {
+		case Redacted:
+			args[i] = v.v
+		case error:
+			args[i] = unredactedArg{err: v}
+		default:
+			args[i] = arg
+		}
+	}
+	return fmt.Errorf(fm.format, args...).Error()
+}
+
+// unredactedArg is an error argument of a [formatMsg] which prints the full
+// message of the error, see [Unredacted].
+type unredactedArg struct{ err error }
+
+func (a unredactedArg) Error() string { return Unredacted(a.err) }
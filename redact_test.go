@@ -0,0 +1,107 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-pogo/errors/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	r := Redact("secret")
+	assert.Equal(t, "secret", r.Value())
+	assert.Equal(t, RedactPlaceholder, r.String())
+	assert.NotContains(t, fmt.Sprintf("%s %v %q %d %#v", r, r, r, r, r), "secret")
+}
+
+func TestRedactedErrors(t *testing.T) {
+	internal.DisableTraceStack()
+	defer internal.EnableTraceStack()
+
+	cause := stderrors.New("cause")
+	tests := map[string]struct {
+		err            error
+		secret         string
+		want, wantFull string
+	}{
+		"Errorf": {
+			secret:   "john@example.com",
+			err:      Errorf("invalid email %s", Redact("john@example.com")),
+			want:     "invalid email [redacted]",
+			wantFull: "invalid email john@example.com",
+		},
+		"Errorf with cause": {
+			secret:   "abc",
+			err:      Errorf("invalid token %q: %w", Redact("abc"), cause),
+			want:     `invalid token [redacted]: cause`,
+			wantFull: `invalid token "abc": cause`,
+		},
+		"Errorf with multiple causes": {
+			secret:   "42",
+			err:      Errorf("user %d: %w, %w", Redact(42), cause, cause),
+			want:     "user [redacted]: cause, cause",
+			wantFull: "user 42: cause, cause",
+		},
+		"Wrapf": {
+			secret:   "john@example.com",
+			err:      Wrapf(cause, "invalid email %s", Redact("john@example.com")),
+			want:     "invalid email [redacted]",
+			wantFull: "invalid email john@example.com",
+		},
+		"WithStack": {
+			secret:   "john@example.com",
+			err:      WithStack(Errorf("invalid email %s", Redact("john@example.com"))),
+			want:     "invalid email [redacted]",
+			wantFull: "invalid email john@example.com",
+		},
+		"nested Errorf": {
+			secret:   "a@b",
+			err:      Errorf("outer: %w", Errorf("email %s", Redact("a@b"))),
+			want:     "outer: email [redacted]",
+			wantFull: "outer: email a@b",
+		},
+		"nested Errorf with %v": {
+			secret:   "a@b",
+			err:      Wrapf(cause, "outer (%v)", Errorf("email %s", Redact("a@b"))),
+			want:     "outer (email [redacted])",
+			wantFull: "outer (email a@b)",
+		},
+		"without redacted args": {
+			err:      Errorf("invalid email %s", "john@example.com"),
+			want:     "invalid email john@example.com",
+			wantFull: "invalid email john@example.com",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.err.Error())
+			assert.Equal(t, tc.wantFull, Unredacted(tc.err))
+			if tc.secret != "" {
+				assert.NotContains(t, fmt.Sprintf("%+v", tc.err), tc.secret)
+			}
+
+			t.Run("RedactMessages disabled", func(t *testing.T) {
+				RedactMessages = false
+				defer func() { RedactMessages = true }()
+				assert.Equal(t, tc.wantFull, tc.err.Error())
+			})
+			t.Run("PrintUnredacted enabled", func(t *testing.T) {
+				PrintUnredacted = true
+				defer func() { PrintUnredacted = false }()
+				assert.Contains(t, fmt.Sprintf("%v", tc.err), tc.want)
+				assert.Contains(t, fmt.Sprintf("%+v", tc.err), tc.wantFull)
+			})
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, "", Unredacted(nil))
+	})
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+)
+
+// RuntimeInfo contains information about the runtime environment in which an
+// error is created.
+type RuntimeInfo struct {
+	// GoroutineID is the id of the goroutine that created the error.
+	GoroutineID uint64
+	// Labels are the pprof labels of the context the error is created with.
+	Labels map[string]string
+	// Build contains information about the main module of the binary.
+	Build BuildInfo
+}
+
+// BuildInfo contains information about the main module of the binary, as read
+// with [debug.ReadBuildInfo].
+type BuildInfo struct {
+	Module   string
+	Version  string
+	Revision string
+}
+
+// RuntimeInfoProvider interfaces provide access to a [RuntimeInfo].
+type RuntimeInfoProvider interface {
+	error
+	RuntimeInfo() RuntimeInfo
+}
+
+// NewCtx creates a new error, like [New], which also captures [RuntimeInfo]
+// consisting of the current goroutine's id, the pprof labels from ctx and the
+// build information of the binary. It will return nil if msg is nil.
+//
+//	pprof.Do(ctx, pprof.Labels("handler", "users"), func(ctx context.Context) {
+//		err = errors.NewCtx(ctx, "user not found")
+//	})
+func NewCtx(ctx context.Context, msg interface{}) error {
+	if msg == nil {
+		return nil
+	}
+
+	err := &runtimeInfoError{
+		embedError: &embedError{error: newCommonErr(toMsg("errors.NewCtx", msg), true, 1)},
+		info:       newRuntimeInfo(ctx),
+	}
+	runHooks(err, 1)
+	return err
+}
+
+// WrapCtx wraps cause with msg, like [Wrap], and captures [RuntimeInfo] in
+// the same way as [NewCtx]. It returns nil when cause is nil, and cause as is
+// when msg is nil.
+func WrapCtx(ctx context.Context, cause error, msg interface{}) error {
+	if cause == nil || msg == nil {
+		return cause
+	}
+
+	err := &runtimeInfoError{
+		embedError: &embedError{error: newWrapErr(toMsg("errors.WrapCtx", msg), cause, 1)},
+		info:       newRuntimeInfo(ctx),
+	}
+	runHooks(err, 1)
+	return err
+}
+
+// WithRuntimeInfo adds [RuntimeInfo] to the error, captured in the same way as
+// [NewCtx]. It returns nil when err is nil.
+func WithRuntimeInfo(ctx context.Context, err error) RuntimeInfoProvider {
+	if err == nil {
+		return nil
+	}
+
+	return &runtimeInfoError{
+		embedError: &embedError{error: err},
+		info:       newRuntimeInfo(ctx),
+	}
+}
+
+// GetRuntimeInfo returns the [RuntimeInfo] of the first found
+// [RuntimeInfoProvider] in err's error chain.
+func GetRuntimeInfo(err error) (RuntimeInfo, bool) {
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if e, ok := err.(RuntimeInfoProvider); ok {
+			return e.RuntimeInfo(), true
+		}
+		err = Unwrap(err)
+	}
+	return RuntimeInfo{}, false
+}
+
+func newRuntimeInfo(ctx context.Context) RuntimeInfo {
+	info := RuntimeInfo{
+		GoroutineID: goroutineID(),
+		Build:       readBuildInfo(),
+	}
+	if ctx != nil {
+		pprof.ForLabels(ctx, func(key, value string) bool {
+			if info.Labels == nil {
+				info.Labels = make(map[string]string, 2)
+			}
+			info.Labels[key] = value
+			return true
+		})
+	}
+	return info
+}
+
+// goroutineID returns the id of the current goroutine, which is parsed from
+// the first line of its stack, e.g. "goroutine 18 [running]:".
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := bytes.TrimPrefix(buf[:runtime.Stack(buf[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		id, _ := strconv.ParseUint(string(b[:i]), 10, 64)
+		return id
+	}
+	return 0
+}
+
+var (
+	buildInfo     BuildInfo
+	buildInfoOnce sync.Once
+)
+
+func readBuildInfo() BuildInfo {
+	buildInfoOnce.Do(func() {
+		bi, ok := debug.ReadBuildInfo()
+		if !ok {
+			return
+		}
+
+		buildInfo.Module = bi.Main.Path
+		buildInfo.Version = bi.Main.Version
+		for _, s := range bi.Settings {
+			if s.Key == "vcs.revision" {
+				buildInfo.Revision = s.Value
+				break
+			}
+		}
+	})
+	return buildInfo
+}
+
+// Format prints the [RuntimeInfo] as detail lines to the [Printer].
+func (ri RuntimeInfo) Format(p Printer) {
+	if ri.GoroutineID != 0 {
+		p.Printf("goroutine: %d\n", ri.GoroutineID)
+	}
+	if len(ri.Labels) != 0 {
+		keys := make([]string, 0, len(ri.Labels))
+		for k := range ri.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var b strings.Builder
+		for i, k := range keys {
+			if i != 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(k)
+			b.WriteByte('=')
+			b.WriteString(ri.Labels[k])
+		}
+		p.Printf("labels: %s\n", b.String())
+	}
+	if ri.Build.Module != "" {
+		p.Printf("build: %s", ri.Build.Module)
+		if ri.Build.Version != "" {
+			p.Printf("@%s", ri.Build.Version)
+		}
+		if ri.Build.Revision != "" {
+			p.Printf(" (%s)", ri.Build.Revision)
+		}
+		p.Print("\n")
+	}
+}
+
+type runtimeInfoError struct {
+	*embedError
+	info RuntimeInfo
+}
+
+func (e *runtimeInfoError) RuntimeInfo() RuntimeInfo { return e.info }
+
+// Format uses [xerrors.FormatError] to call the [FormatError] method of the
+// error with a [Printer] configured according to s and v, and writes the
+// result to s.
+func (e *runtimeInfoError) Format(s fmt.State, v rune) {
+	xerrors.FormatError(e, s, v)
+}
+
+// FormatError prints the error to the [Printer], including its [RuntimeInfo]
+// and stack trace when details are requested, and returns the next error in
+// the error chain, if any.
+func (e *runtimeInfoError) FormatError(p Printer) error {
+	msg := e.Error()
+	p.Print(msg)
+	if p.Detail() {
+		printUnredacted(p, e, msg)
+		e.info.Format(p)
+		if stack := e.StackTrace(); stack != nil {
+			stack.Format(p)
+		}
+	}
+	return formatNext(Unwrap(Unembed(e.error)), e.StackTrace())
+}
+
+// GoString prints the error in basic Go syntax.
+func (e *runtimeInfoError) GoString() string {
+	return fmt.Sprintf(
+		"errors.runtimeInfoError{info: %#v, embedErr: %#v}",
+		e.info,
+		e.error,
+	)
+}
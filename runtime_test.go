@@ -0,0 +1,87 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	"github.com/go-pogo/errors/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCtx(t *testing.T) {
+	assert.Nil(t, NewCtx(context.Background(), nil))
+
+	var err error
+	pprof.Do(context.Background(), pprof.Labels("handler", "users", "method", "GET"), func(ctx context.Context) {
+		err = NewCtx(ctx, "user not found")
+	})
+
+	assert.Equal(t, "user not found", err.Error())
+	assert.ErrorIs(t, err, Msg("user not found"))
+
+	info, ok := GetRuntimeInfo(err)
+	assert.True(t, ok)
+	assert.Equal(t, goroutineID(), info.GoroutineID)
+	assert.Equal(t, map[string]string{"handler": "users", "method": "GET"}, info.Labels)
+	assert.Equal(t, readBuildInfo(), info.Build)
+
+	detail := fmt.Sprintf("%+v", err)
+	assert.Contains(t, detail, fmt.Sprintf("goroutine: %d\n", info.GoroutineID))
+	assert.Contains(t, detail, "labels: handler=users, method=GET\n")
+	assert.Equal(t, "user not found", fmt.Sprintf("%v", err))
+	if internal.TraceStack {
+		assert.Contains(t, detail, "runtime_test.go:")
+	}
+}
+
+func TestWrapCtx(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, WrapCtx(context.Background(), nil, "msg"))
+	})
+	t.Run("nil msg", func(t *testing.T) {
+		cause := stderrors.New("cause")
+		assert.Same(t, cause, WrapCtx(context.Background(), cause, nil))
+	})
+
+	cause := stderrors.New("cause")
+	err := WrapCtx(context.Background(), cause, "wrap")
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "wrap: cause", fmt.Sprintf("%v", err))
+
+	info, ok := GetRuntimeInfo(Wrap(err, "outer"))
+	assert.True(t, ok)
+	assert.NotZero(t, info.GoroutineID)
+	assert.Nil(t, info.Labels)
+}
+
+func TestWithRuntimeInfo(t *testing.T) {
+	assert.Nil(t, WithRuntimeInfo(context.Background(), nil))
+
+	err := stderrors.New("err")
+	have := WithRuntimeInfo(nil, err)
+	assert.ErrorIs(t, have, err)
+	assert.NotZero(t, have.RuntimeInfo().GoroutineID)
+
+	_, ok := GetRuntimeInfo(err)
+	assert.False(t, ok)
+}
+
+func TestRuntimeInfo_Format(t *testing.T) {
+	info := RuntimeInfo{
+		GoroutineID: 7,
+		Labels:      map[string]string{"b": "2", "a": "1"},
+		Build:       BuildInfo{Module: "example.com/app", Version: "v1.2.3", Revision: "abc"},
+	}
+
+	var b strings.Builder
+	info.Format(&framesPrinter{&b})
+	assert.Equal(t, "goroutine: 7\nlabels: a=1, b=2\nbuild: example.com/app@v1.2.3 (abc)\n", b.String())
+}
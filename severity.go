@@ -0,0 +1,109 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"fmt"
+)
+
+// Severity indicates how severe an error is. The zero value indicates no
+// severity is set.
+type Severity uint8
+
+const (
+	SeverityDebug Severity = iota + 1
+	SeverityInfo
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+)
+
+var severityNames = [...]string{
+	SeverityDebug:    "debug",
+	SeverityInfo:     "info",
+	SeverityWarning:  "warning",
+	SeverityError:    "error",
+	SeverityCritical: "critical",
+}
+
+// String returns the lowercase name of the [Severity], or an empty string when
+// the severity is unknown.
+func (s Severity) String() string {
+	if int(s) < len(severityNames) {
+		return severityNames[s]
+	}
+	return ""
+}
+
+// SeverityProvider interfaces provide access to a [Severity].
+type SeverityProvider interface {
+	error
+	Severity() Severity
+}
+
+// SeveritySetter interfaces provide access to a [Severity] which can be
+// changed.
+type SeveritySetter interface {
+	SeverityProvider
+	SetSeverity(Severity)
+}
+
+// WithSeverity adds a [Severity] to the error, which can be retrieved using
+// [GetSeverity]. It will return nil when the provided error is nil.
+//
+//	err = errors.WithSeverity(err, errors.SeverityWarning)
+func WithSeverity(err error, severity Severity) SeverityProvider {
+	if err == nil {
+		return nil
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	if e, ok := err.(SeveritySetter); ok {
+		e.SetSeverity(severity)
+		return e
+	}
+
+	return &severityError{
+		embedError: &embedError{error: err},
+		severity:   severity,
+	}
+}
+
+// GetSeverity returns the [Severity] from the first found [SeverityProvider]
+// in err's error chain. If none is found, it returns [SeverityError].
+func GetSeverity(err error) Severity { return GetSeverityOr(err, SeverityError) }
+
+// GetSeverityOr returns the [Severity] from the first found
+// [SeverityProvider], with a non-zero severity, in err's error chain. If none
+// is found, it returns the provided value or.
+func GetSeverityOr(err error, or Severity) Severity {
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if e, ok := err.(SeverityProvider); ok {
+			if s := e.Severity(); s != 0 {
+				return s
+			}
+		}
+		err = Unwrap(err)
+	}
+	return or
+}
+
+type severityError struct {
+	*embedError
+	severity Severity
+}
+
+func (e *severityError) SetSeverity(s Severity) { e.severity = s }
+func (e *severityError) Severity() Severity     { return e.severity }
+
+// GoString prints the error in basic Go syntax.
+func (e *severityError) GoString() string {
+	return fmt.Sprintf(
+		"errors.severityError{severity: %s, embedErr: %#v}",
+		e.severity.String(),
+		e.error,
+	)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverity_String(t *testing.T) {
+	tests := map[Severity]string{
+		0:                "",
+		SeverityDebug:    "debug",
+		SeverityInfo:     "info",
+		SeverityWarning:  "warning",
+		SeverityError:    "error",
+		SeverityCritical: "critical",
+		100:              "",
+	}
+	for sev, want := range tests {
+		assert.Equal(t, want, sev.String())
+	}
+}
+
+func TestWithSeverity(t *testing.T) {
+	for name, wantErr := range provideErrors(true) {
+		t.Run(name, func(t *testing.T) {
+			haveErr := WithSeverity(wantErr, SeverityInfo)
+			assert.Exactly(t, SeverityInfo, GetSeverity(haveErr))
+			assert.ErrorIs(t, haveErr, wantErr)
+
+			t.Run("update", func(t *testing.T) {
+				haveErr2 := WithSeverity(haveErr, SeverityCritical)
+				assert.Exactly(t, SeverityCritical, GetSeverity(haveErr2))
+				assert.Same(t, haveErr, haveErr2)
+			})
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Exactly(t, nil, WithSeverity(nil, SeverityWarning))
+	})
+}
+
+func TestGetSeverityOr(t *testing.T) {
+	var cat Catalog
+	code := cat.Define(Code{ID: "E1", Severity: SeverityDebug})
+	noSeverity := cat.Define(Code{ID: "E2"})
+
+	tests := map[string]struct {
+		err  error
+		or   Severity
+		want Severity
+	}{
+		"nil": {
+			err:  nil,
+			or:   SeverityInfo,
+			want: SeverityInfo,
+		},
+		"std error": {
+			err:  stderrors.New("std err"),
+			or:   SeverityWarning,
+			want: SeverityWarning,
+		},
+		"error with severity": {
+			err:  Wrap(WithSeverity(New("foo"), SeverityCritical), "bar"),
+			want: SeverityCritical,
+		},
+		"code": {
+			err:  code.New(),
+			or:   SeverityError,
+			want: SeverityDebug,
+		},
+		"code without severity": {
+			err:  noSeverity.New(),
+			or:   SeverityInfo,
+			want: SeverityInfo,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, GetSeverityOr(tc.err, tc.or))
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		assert.Exactly(t, SeverityError, GetSeverity(New("some err")))
+	})
+}
@@ -7,6 +7,7 @@ package errors
 import (
 	"fmt"
 	"io"
+	"math"
 	"runtime"
 	"strings"
 
@@ -42,11 +43,9 @@ func WithStack(err error) StackTracer {
 	default:
 		ee := &embedError{error: v}
 		if internal.TraceStack {
-			ee.stack = newStackTrace(1)
-			if u := Unwrap(v); u != nil {
-				skipStackTrace(u, ee.stack.Len())
-			}
+			ee.stack = newStackTrace(1, GetStackTrace(Unwrap(v)))
 		}
+		runHooks(ee, 1)
 		return ee
 	}
 }
@@ -66,18 +65,53 @@ func GetStackTrace(err error) *StackTrace {
 }
 
 type StackTrace struct {
-	frames   []uintptr
-	reversed bool
+	// own contains the captured frames, innermost first, which are not
+	// shared with tail.
+	own []uintptr
+	// tail is the stack trace of a cause of which frames from index from are
+	// shared with this stack trace, so they are not stored more than once.
+	tail *StackTrace
+	from int
+	// n is the total number of frames.
+	n         int
+	truncated bool
 
 	// Skip n frames when formatting with [Format], so overlapping frames from
-	// previous errors are not printed.
+	// previous errors are not printed. When 0, the frames that are shared with
+	// the stack trace of the wrapping error are skipped.
 	Skip uint
 }
 
-const framesDepth = 16
+const (
+	framesDepth = 16
+	// framesBuffer is the number of frames which are captured without
+	// allocating memory on the heap.
+	framesBuffer = 4 * framesDepth
+)
+
+// newStackTrace captures a new [StackTrace] according to the current
+// [StackTraceOptions]. Frames that are shared with the stack trace of cause
+// are not stored again but referenced. It returns nil when stack traces are
+// disabled using [DisableStackTrace], or when the options exclude the caller
+// from capturing.
+func newStackTrace(skipFrames uint, cause *StackTrace) *StackTrace {
+	if traceDisabled.Load() {
+		return nil
+	}
+
+	limit := math.MaxInt
+	if opts := traceOpts.Load(); opts != nil {
+		if !opts.capture(int(skipFrames) + 3) {
+			return nil
+		}
+		if opts.MaxDepth > 0 {
+			limit = int(opts.MaxDepth)
+		}
+	}
 
-func newStackTrace(skipFrames uint) *StackTrace {
-	st := &StackTrace{frames: make([]uintptr, 0, framesDepth)}
+	var buf [framesBuffer]uintptr
+	frames := buf[:0]
+	truncated := false
 
 	skip := int(skipFrames) + 2
 	var pc [framesDepth]uintptr
@@ -86,8 +120,13 @@ func newStackTrace(skipFrames uint) *StackTrace {
 		if n == 0 {
 			break
 		}
+		if rem := limit - len(frames); n > rem {
+			frames = append(frames, pc[:rem]...)
+			truncated = true
+			break
+		}
 
-		st.frames = append(st.frames, pc[:n]...)
+		frames = append(frames, pc[:n]...)
 		skip += n
 
 		if n < framesDepth {
@@ -95,33 +134,104 @@ func newStackTrace(skipFrames uint) *StackTrace {
 		}
 	}
 
-	st.frames = st.frames[:len(st.frames)-2]
+	// remove runtime.main/testing.tRunner and runtime.goexit frames
+	if !truncated && len(frames) >= 2 {
+		frames = frames[:len(frames)-2]
+	}
+
+	st := &StackTrace{n: len(frames), truncated: truncated}
+	if !truncated && cause != nil && !cause.truncated {
+		if shared := cause.sharedTail(frames); shared > 0 {
+			frames = frames[:len(frames)-shared]
+			st.tail, st.from = cause.seek(cause.n - shared)
+		}
+	}
+	if len(frames) != 0 {
+		st.own = make([]uintptr, len(frames))
+		copy(st.own, frames)
+	}
 	return st
 }
 
-func skipStackTrace(err error, skip uint) {
-	if skip == 0 {
-		return
-	}
+// sharedTail returns the number of root frames of frames that are equal to
+// the root frames of the [StackTrace].
+func (st *StackTrace) sharedTail(frames []uintptr) int {
+	i := len(frames) - 1
+	st.walkReverse(func(pc uintptr) bool {
+		if i < 0 || frames[i] != pc {
+			return false
+		}
+		i--
+		return true
+	})
+	return len(frames) - 1 - i
+}
 
-	st := GetStackTrace(err)
-	if st == nil || st.Len() < skip {
-		return
+// walkReverse calls fn for each frame, starting at the root, until fn
+// returns false.
+func (st *StackTrace) walkReverse(fn func(pc uintptr) bool) bool {
+	return st.walkFrom(0, fn)
+}
+
+// walkFrom is like walkReverse, but stops at the frame with index from.
+func (st *StackTrace) walkFrom(from int, fn func(pc uintptr) bool) bool {
+	if st == nil {
+		return true
+	}
+	// walk the shared frames, which are closest to the root, first
+	if !st.tail.walkFrom(st.from, fn) {
+		return false
 	}
+	for i := len(st.own) - 1; i >= from; i-- {
+		if !fn(st.own[i]) {
+			return false
+		}
+	}
+	return true
+}
 
-	st.Skip = skip - 1
+// seek returns the [StackTrace] and index within its own frames, of the
+// frame at index i.
+func (st *StackTrace) seek(i int) (*StackTrace, int) {
+	for st != nil && i >= len(st.own) {
+		i = i - len(st.own) + st.from
+		st = st.tail
+	}
+	return st, i
 }
 
-func (st *StackTrace) reverseFrames() {
-	if st.reversed {
-		return
+// sharedRoot returns the number of root frames the [StackTrace] has in common
+// with the stack trace of parent, so they can be skipped when formatting. At
+// least one frame is never skipped. Truncated stack traces do not contain the
+// shared root frames and are therefore ignored.
+func (st *StackTrace) sharedRoot(parent *StackTrace) uint {
+	if parent.Len() == 0 || parent.truncated || st.truncated {
+		return 0
 	}
 
-	n := len(st.frames)
-	for i := n/2 - 1; i >= 0; i-- {
-		opp := n - 1 - i
-		st.frames[i], st.frames[opp] = st.frames[opp], st.frames[i]
+	frames := parent.rootFirst()
+	var n int
+	st.walkReverse(func(pc uintptr) bool {
+		if n == len(frames) || frames[n] != pc {
+			return false
+		}
+		n++
+		return true
+	})
+	if n == st.n {
+		n--
 	}
+	return uint(n)
+}
+
+// rootFirst returns all frames, starting with the root frame.
+func (st *StackTrace) rootFirst() []uintptr {
+	frames := make([]uintptr, 0, st.n)
+	st.walkReverse(func(pc uintptr) bool {
+		frames = append(frames, pc)
+		return true
+	})
+	return frames
 }
 
 type Frame uintptr
@@ -146,19 +256,26 @@ func (fr Frame) FileLine() (file string, line int) {
 // Frames returns a slice of [Frame]. Use [StackTrace.CallersFrames] instead if
 // you want to access the whole stack trace of frames.
 func (st *StackTrace) Frames() []Frame {
-	st.reverseFrames()
-	frames := make([]Frame, len(st.frames))
-	for i, pc := range st.frames {
-		frames[i] = Frame(pc)
+	if st == nil {
+		return nil
 	}
+
+	frames := make([]Frame, 0, st.n)
+	st.walkReverse(func(pc uintptr) bool {
+		frames = append(frames, Frame(pc))
+		return true
+	})
 	return frames
 }
 
 // CallersFrames returns a [runtime.Frames] by calling [runtime.CallersFrames]
 // with the captured stack trace frames as callers argument.
 func (st *StackTrace) CallersFrames() *runtime.Frames {
-	st.reverseFrames()
-	return runtime.CallersFrames(st.frames)
+	if st == nil {
+		return runtime.CallersFrames(nil)
+	}
+
+	return runtime.CallersFrames(st.rootFirst())
 }
 
 // Len returns the amount of captures frames.
@@ -166,16 +283,29 @@ func (st *StackTrace) Len() uint {
 	if nil == st {
 		return 0
 	}
-	return uint(len(st.frames))
+	return uint(st.n)
 }
 
+// Truncated indicates if the [StackTrace] is limited by
+// [StackTraceOptions.MaxDepth] and does not contain all frames.
+func (st *StackTrace) Truncated() bool { return st != nil && st.truncated }
+
 // Format formats the slice of [xerrors.Frame] using a [xerrors.Printer]. It
-// will skip n frames according to [StackTrace.Skip], when printing so no
-// overlapping frames with underlying errors are displayed.
+// will skip frames according to [StackTrace.Skip], or the frames shared with
+// the stack trace of the wrapping error when the error is formatted as part of
+// an error chain, when printing so no overlapping frames with underlying errors
+// are displayed.
 func (st *StackTrace) Format(printer xerrors.Printer) {
-	if printer.Detail() {
-		st.printFrames(printer, st.Skip)
+	if st == nil || !printer.Detail() {
+		return
+	}
+
+	skip := st.Skip
+	//goland:noinspection GoTypeAssertionOnErrors
+	if p, ok := printer.(*nextPrinter); ok && skip == 0 {
+		skip = st.sharedRoot(p.parent)
 	}
+	st.printFrames(printer, skip)
 }
 
 // String returns a formatted string of the complete stack trace.
@@ -186,8 +316,11 @@ func (st *StackTrace) String() string {
 }
 
 func (st *StackTrace) printFrames(p Printer, skip uint) {
-	st.reverseFrames()
-	PrintFrames(p, runtime.CallersFrames(st.frames[skip:]))
+	if st.Len() <= skip {
+		return
+	}
+
+	PrintFrames(p, runtime.CallersFrames(st.rootFirst()[skip:]))
 }
 
 // PrintFrames prints a complete stack of [runtime.Frames] using [Printer] p.
@@ -214,3 +347,41 @@ func (p *framesPrinter) Printf(format string, args ...interface{}) {
 }
 
 func (*framesPrinter) Detail() bool { return true }
+
+// formatNext returns next, the next error in the chain of an error with
+// stack trace parent, so that when it is formatted, the frames its stack trace
+// shares with parent are skipped. The errors and their stack traces are not
+// modified, so they can safely be formatted from multiple goroutines.
+func formatNext(next error, parent *StackTrace) error {
+	if next == nil || parent == nil {
+		return next
+	}
+
+	//goland:noinspection GoTypeAssertionOnErrors
+	if f, ok := next.(Formatter); ok {
+		return &nextFormatter{Formatter: f, parent: parent}
+	}
+	return next
+}
+
+// nextFormatter formats the [Formatter] with a [nextPrinter] that provides
+// the stack trace of the error it is the next error of.
+type nextFormatter struct {
+	Formatter
+	parent *StackTrace
+}
+
+func (f *nextFormatter) Format(s fmt.State, v rune) {
+	xerrors.FormatError(f, s, v)
+}
+
+func (f *nextFormatter) FormatError(p Printer) error {
+	return f.Formatter.FormatError(&nextPrinter{Printer: p, parent: f.parent})
+}
+
+// nextPrinter is a [Printer] that is used to format the next error in the
+// chain of an error with stack trace parent.
+type nextPrinter struct {
+	Printer
+	parent *StackTrace
+}
@@ -40,3 +40,65 @@ func TestWithStack(t *testing.T) {
 		assert.Same(t, err, have)
 	})
 }
+
+func TestStackTrace_seek(t *testing.T) {
+	// frames, innermost first: 1, 2, 3, 4, 5, 6
+	root := &StackTrace{own: []uintptr{9, 4, 5, 6}, n: 4}
+	mid := &StackTrace{own: []uintptr{2, 3}, tail: root, from: 1, n: 5}
+	st := &StackTrace{own: []uintptr{1}, tail: mid, from: 0, n: 6}
+
+	var frames []uintptr
+	st.walkReverse(func(pc uintptr) bool {
+		frames = append(frames, pc)
+		return true
+	})
+	assert.Equal(t, []uintptr{6, 5, 4, 3, 2, 1}, frames)
+	assert.Equal(t, frames, st.rootFirst())
+
+	tests := map[int]struct {
+		st  *StackTrace
+		idx int
+	}{
+		0: {st, 0},
+		1: {mid, 0},
+		2: {mid, 1},
+		3: {root, 1},
+		5: {root, 3},
+	}
+	for i, tc := range tests {
+		have, idx := st.seek(i)
+		assert.Same(t, tc.st, have, "%d", i)
+		assert.Equal(t, tc.idx, idx, "%d", i)
+	}
+
+	assert.Equal(t, 3, st.sharedTail([]uintptr{7, 4, 5, 6}))
+	assert.Equal(t, 0, st.sharedTail([]uintptr{7, 8}))
+	assert.Equal(t, 6, st.sharedTail([]uintptr{1, 2, 3, 4, 5, 6}))
+}
+
+func newWrapChain(depth int) error {
+	if depth == 0 {
+		return New("cause")
+	}
+	return Wrap(newWrapChain(depth-1), "wrap")
+}
+
+func BenchmarkWrap(b *testing.B) {
+	for _, depth := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("depth=%d", depth), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = newWrapChain(depth)
+			}
+		})
+	}
+}
+
+func BenchmarkStackTrace_Frames(b *testing.B) {
+	st := GetStackTrace(newWrapChain(10))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = st.Frames()
+	}
+}
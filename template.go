@@ -0,0 +1,133 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Template is a format string, like the one used with [Errorf], which can
+// also be used as a basic error, just like [Msg]. Errors created from a
+// Template keep a reference to it and are considered to be equal to it when
+// comparing with [Is], regardless of their arguments.
+//
+//	const ErrUserNotFound errors.Template = "user %q not found"
+//
+//	err := ErrUserNotFound.New("roel")
+//	errors.Is(err, ErrUserNotFound) // true
+//	fmt.Println(err)                // user "roel" not found
+type Template string
+
+// New creates a new error, like [Errorf], with the [Template] as format and
+// args as its arguments. The %w verb can be used to wrap errors, with the same
+// semantics as [Errorf]. The arguments are available as [Fields], with keys
+// "arg0", "arg1" etc., using [GetFields], or as a slice using
+// [Template.Args].
+func (t Template) New(args ...interface{}) error {
+	msg := &templateMsg{
+		formatMsg: newFormatMsg(string(t), args),
+		tmpl:      t,
+	}
+
+	var fields Fields
+	if len(args) != 0 {
+		fields = make(Fields, len(args))
+		for i, arg := range args {
+			fields["arg"+strconv.Itoa(i)] = arg
+		}
+	}
+
+	err := &fieldsError{
+		embedError: &embedError{error: newFormatErr(msg, msg.formatMsg, 1)},
+		fields:     fields,
+	}
+	runHooks(err, 1)
+	return err
+}
+
+// Args returns the arguments of the first error in err's error chain which is
+// created from the [Template] using [Template.New].
+//
+//	if args, ok := ErrUserNotFound.Args(err); ok {
+//		log.Println("unknown user:", args[0])
+//	}
+func (t Template) Args(err error) ([]interface{}, bool) {
+	var res []interface{}
+	var found bool
+	walkChain(err, func(e error) bool {
+		if tm := getTemplateMsg(e); tm != nil && tm.tmpl == t {
+			res, found = tm.args, true
+			return false
+		}
+		return true
+	})
+	return res, found
+}
+
+func (t Template) Is(target error) bool {
+	//goland:noinspection GoTypeAssertionOnErrors
+	switch tt := target.(type) {
+	case Template:
+		return t == tt
+	case *Template:
+		return t == *tt
+	default:
+		return false
+	}
+}
+
+// String returns the format string of the [Template].
+func (t Template) String() string { return string(t) }
+
+// Error returns the format string of the [Template].
+func (t Template) Error() string { return string(t) }
+
+// GoString prints the error in basic Go syntax.
+func (t Template) GoString() string { return `errors.Template("` + string(t) + `")` }
+
+// templateMsg is the message of an error created with [Template.New].
+type templateMsg struct {
+	*formatMsg
+	tmpl Template
+}
+
+func (tm *templateMsg) Is(target error) bool { return tm.tmpl.Is(target) }
+
+// GoString prints the error in basic Go syntax.
+func (tm *templateMsg) GoString() string {
+	return fmt.Sprintf("errors.templateMsg{tmpl: %#v, args: %#v}", tm.tmpl, tm.args)
+}
+
+// asFormatMsg returns the [formatMsg] of msg, which is the message of an error
+// created with [Errorf], [Wrapf] or [Template.New]. It returns nil when msg
+// is not such a message.
+func asFormatMsg(msg error) *formatMsg {
+	//goland:noinspection GoTypeAssertionOnErrors
+	switch m := msg.(type) {
+	case *formatMsg:
+		return m
+	case *templateMsg:
+		return m.formatMsg
+	default:
+		return nil
+	}
+}
+
+// getTemplateMsg returns the [templateMsg] of err when it is created from a
+// [Template], or nil otherwise.
+func getTemplateMsg(err error) *templateMsg {
+	//goland:noinspection GoTypeAssertionOnErrors
+	switch e := err.(type) {
+	case *commonError:
+		tm, _ := e.error.(*templateMsg)
+		return tm
+	case *multiErr:
+		tm, _ := e.msg.(*templateMsg)
+		return tm
+	default:
+		return nil
+	}
+}
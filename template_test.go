@@ -0,0 +1,96 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate_New(t *testing.T) {
+	const tmpl Template = "user %q not found"
+
+	t.Run("message", func(t *testing.T) {
+		err := tmpl.New("roel")
+		assert.Equal(t, `user "roel" not found`, err.Error())
+		assert.Equal(t, fmt.Sprintf("%v", Errorf(string(tmpl), "roel")), fmt.Sprintf("%v", err))
+	})
+	t.Run("is", func(t *testing.T) {
+		err := tmpl.New("roel")
+		assert.ErrorIs(t, err, tmpl)
+		assert.ErrorIs(t, Wrap(err, "ctx"), tmpl)
+		assert.NotErrorIs(t, err, Template("other %q"))
+		assert.NotErrorIs(t, Errorf(string(tmpl), "roel"), tmpl)
+	})
+	t.Run("fields", func(t *testing.T) {
+		err := tmpl.New("roel")
+		assert.Equal(t, Fields{"arg0": "roel"}, GetFields(err))
+		assert.Nil(t, GetFields(Template("no args").New()))
+	})
+	t.Run("wrap", func(t *testing.T) {
+		cause := stderrors.New("cause")
+		err := Template("failed to load %s: %w").New("config", cause)
+		assert.Equal(t, "failed to load config: cause", err.Error())
+		assert.ErrorIs(t, err, cause)
+		assert.Same(t, cause, Unwrap(Unwrap(err)))
+	})
+	t.Run("wrap multiple", func(t *testing.T) {
+		err1, err2 := stderrors.New("err1"), stderrors.New("err2")
+		const multi Template = "%w and %w"
+
+		err := multi.New(err1, err2)
+		assert.Equal(t, "err1 and err2", err.Error())
+		assert.ErrorIs(t, err, multi)
+		assert.ErrorIs(t, err, err1)
+		assert.ErrorIs(t, err, err2)
+	})
+	t.Run("redacted", func(t *testing.T) {
+		err := Template("user %s").New(Redact("secret"))
+
+		prev := RedactMessages
+		RedactMessages = true
+		defer func() { RedactMessages = prev }()
+
+		assert.Equal(t, "user "+RedactPlaceholder, err.Error())
+		assert.Equal(t, "user secret", Unredacted(err))
+	})
+	t.Run("message key", func(t *testing.T) {
+		assert.Equal(t, string(tmpl), MessageKey(tmpl.New("roel")))
+		assert.Equal(t, "%w and %w", MessageKey(Template("%w and %w").New(New("a"), New("b"))))
+	})
+	t.Run("localize", func(t *testing.T) {
+		RegisterTranslations("xt", map[string]string{string(tmpl): "gebruiker %q niet gevonden"})
+		assert.Equal(t, `gebruiker "roel" niet gevonden`, Localize(tmpl.New("roel"), "xt"))
+	})
+}
+
+func TestTemplate_Args(t *testing.T) {
+	const tmpl Template = "user %q not found"
+
+	err := Wrap(tmpl.New("roel"), "ctx")
+	args, ok := tmpl.Args(err)
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"roel"}, args)
+
+	args, ok = Template("other").Args(err)
+	assert.False(t, ok)
+	assert.Nil(t, args)
+
+	_, ok = tmpl.Args(nil)
+	assert.False(t, ok)
+}
+
+func TestTemplate_Fingerprint(t *testing.T) {
+	const tmpl Template = "user %q not found"
+	assert.Equal(t, Fingerprint(tmpl.New("roel")), Fingerprint(tmpl.New("bob")))
+	assert.NotEqual(t, Fingerprint(tmpl.New("roel")), Fingerprint(Template("other %q").New("roel")))
+}
+
+func TestTemplate_GoString(t *testing.T) {
+	assert.Equal(t, `errors.Template("user %q")`, fmt.Sprintf("%#v", Template("user %q")))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-pogo/errors/internal"
+)
+
+var (
+	traceDisabled atomic.Bool
+	traceOpts     atomic.Pointer[StackTraceOptions]
+)
+
+// EnableStackTrace (re-)enables capturing of stack traces when errors are
+// created. It has no effect when the program is compiled with the "notrace"
+// build tag.
+func EnableStackTrace() { traceDisabled.Store(false) }
+
+// DisableStackTrace disables capturing of stack traces when errors are
+// created. Errors that already have a [StackTrace] keep it.
+func DisableStackTrace() { traceDisabled.Store(true) }
+
+// StackTraceEnabled indicates if stack traces are captured when errors are
+// created.
+func StackTraceEnabled() bool { return internal.TraceStack && !traceDisabled.Load() }
+
+// StackTraceOptions control when and how stack traces are captured. Its zero
+// value captures a complete stack trace for each created error.
+type StackTraceOptions struct {
+	// MaxDepth is the maximum number of frames that are captured. Stack
+	// traces that are limited by MaxDepth are marked as
+	// [StackTrace.Truncated]. A value of 0 means no limit.
+	MaxDepth uint
+	// Packages limits capturing to errors that are created from within
+	// packages that have any of these import path prefixes, e.g.
+	// "github.com/my/app" also matches "github.com/my/app/internal". All
+	// packages match when empty.
+	Packages []string
+	// SampleRate is the fraction of errors, between 0 and 1, of which a stack
+	// trace is captured. A value of 0 or 1 and above captures all.
+	SampleRate float64
+}
+
+// SetStackTraceOptions sets the [StackTraceOptions] used when capturing stack
+// traces. It is safe to call while errors are being created.
+//
+//	errors.SetStackTraceOptions(errors.StackTraceOptions{
+//		MaxDepth: 32,
+//		Packages: []string{"github.com/my/app"},
+//	})
+func SetStackTraceOptions(opts StackTraceOptions) {
+	if opts.MaxDepth == 0 && len(opts.Packages) == 0 && opts.SampleRate == 0 {
+		traceOpts.Store(nil)
+		return
+	}
+
+	opts.Packages = append([]string(nil), opts.Packages...)
+	traceOpts.Store(&opts)
+}
+
+// GetStackTraceOptions returns the current [StackTraceOptions].
+func GetStackTraceOptions() StackTraceOptions {
+	if opts := traceOpts.Load(); opts != nil {
+		res := *opts
+		res.Packages = append([]string(nil), opts.Packages...)
+		return res
+	}
+	return StackTraceOptions{}
+}
+
+// capture indicates if a stack trace should be captured for an error created
+// by the caller at skipFrames, which is passed to [runtime.Callers].
+func (opts *StackTraceOptions) capture(skipFrames int) bool {
+	if opts.SampleRate > 0 && opts.SampleRate < 1 && rand.Float64() >= opts.SampleRate {
+		return false
+	}
+	if len(opts.Packages) == 0 {
+		return true
+	}
+
+	var pc [1]uintptr
+	if runtime.Callers(skipFrames, pc[:]) == 0 {
+		return false
+	}
+
+	fn := Frame(pc[0] - 1).Func()
+	if fn == nil {
+		return false
+	}
+
+	pkg := funcPackage(fn.Name())
+	for _, prefix := range opts.Packages {
+		if matchPackage(pkg, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// funcPackage returns the package path of the fully qualified function name,
+// e.g. "github.com/go-pogo/errors" for "github.com/go-pogo/errors.New".
+func funcPackage(name string) string {
+	slash := strings.LastIndexByte(name, '/')
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot >= 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
+
+// matchPackage indicates if pkg equals prefix or is a sub package of prefix.
+func matchPackage(pkg, prefix string) bool {
+	if !strings.HasPrefix(pkg, prefix) {
+		return false
+	}
+	return len(pkg) == len(prefix) ||
+		strings.HasSuffix(prefix, "/") ||
+		pkg[len(prefix)] == '/'
+}
@@ -34,3 +34,142 @@ func TestGetStackTrace(t *testing.T) {
 		})
 	}
 }
+
+func TestDisableStackTrace(t *testing.T) {
+	DisableStackTrace()
+	assert.False(t, StackTraceEnabled())
+	assert.Nil(t, GetStackTrace(New("err")))
+	assert.Nil(t, GetStackTrace(WithStack(stderrors.New("err"))))
+	assert.Nil(t, GetStackTrace(Join(New("err1"), New("err2"))))
+
+	EnableStackTrace()
+	assert.True(t, StackTraceEnabled())
+	assert.NotNil(t, GetStackTrace(New("err")))
+}
+
+func TestSetStackTraceOptions(t *testing.T) {
+	defer SetStackTraceOptions(StackTraceOptions{})
+
+	t.Run("zero", func(t *testing.T) {
+		SetStackTraceOptions(StackTraceOptions{})
+		assert.Nil(t, traceOpts.Load())
+		assert.Equal(t, StackTraceOptions{}, GetStackTraceOptions())
+	})
+	t.Run("max depth", func(t *testing.T) {
+		SetStackTraceOptions(StackTraceOptions{MaxDepth: 1})
+		st := GetStackTrace(New("err"))
+		assert.Equal(t, uint(1), st.Len())
+		assert.True(t, st.Truncated())
+		assert.Contains(t, st.String(), "TestSetStackTraceOptions")
+
+		SetStackTraceOptions(StackTraceOptions{MaxDepth: 100})
+		st = GetStackTrace(New("err"))
+		assert.Equal(t, uint(1), st.Len())
+		assert.False(t, st.Truncated())
+	})
+	t.Run("truncated cause", func(t *testing.T) {
+		SetStackTraceOptions(StackTraceOptions{MaxDepth: 1})
+		cause := New("cause")
+		err := Wrap(cause, "err")
+		assert.Equal(t, uint(0), GetStackTrace(cause).sharedRoot(GetStackTrace(err)))
+	})
+	t.Run("packages", func(t *testing.T) {
+		SetStackTraceOptions(StackTraceOptions{Packages: []string{"github.com/go-pogo/errors"}})
+		assert.NotNil(t, GetStackTrace(New("err")))
+		assert.NotNil(t, GetStackTrace(Errorf("err %d", 1)))
+		assert.NotNil(t, GetStackTrace(WithStack(stderrors.New("err"))))
+
+		SetStackTraceOptions(StackTraceOptions{Packages: []string{"github.com/go-pogo/errors/errlist"}})
+		assert.Nil(t, GetStackTrace(New("err")))
+		assert.Nil(t, GetStackTrace(Wrap(stderrors.New("err"), "wrap")))
+		assert.Nil(t, GetStackTrace(Join(stderrors.New("err1"), stderrors.New("err2"))))
+	})
+	t.Run("sample rate", func(t *testing.T) {
+		SetStackTraceOptions(StackTraceOptions{SampleRate: 0.5})
+
+		var n int
+		for i := 0; i < 1000; i++ {
+			if GetStackTrace(New("err")) != nil {
+				n++
+			}
+		}
+		assert.Greater(t, n, 0)
+		assert.Less(t, n, 1000)
+	})
+	t.Run("copy packages", func(t *testing.T) {
+		pkgs := []string{"foo"}
+		SetStackTraceOptions(StackTraceOptions{Packages: pkgs})
+		pkgs[0] = "bar"
+		assert.Equal(t, []string{"foo"}, GetStackTraceOptions().Packages)
+	})
+}
+
+func TestMatchPackage(t *testing.T) {
+	tests := map[string]struct {
+		pkg, prefix string
+		want        bool
+	}{
+		"equal":         {"github.com/my/app", "github.com/my/app", true},
+		"sub package":   {"github.com/my/app/sub", "github.com/my/app", true},
+		"slash suffix":  {"github.com/my/app/sub", "github.com/my/", true},
+		"other package": {"github.com/my/application", "github.com/my/app", false},
+		"no match":      {"github.com/other", "github.com/my/app", false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, matchPackage(tc.pkg, tc.prefix))
+		})
+	}
+}
+
+func TestFuncPackage(t *testing.T) {
+	assert.Equal(t, "github.com/go-pogo/errors", funcPackage("github.com/go-pogo/errors.New"))
+	assert.Equal(t, "github.com/go-pogo/errors", funcPackage("github.com/go-pogo/errors.(*StackTrace).Len"))
+	assert.Equal(t, "main", funcPackage("main.main.func1"))
+}
+
+func captureStackTrace(cause *StackTrace) *StackTrace { return newStackTrace(0, cause) }
+
+func captureNestedStackTrace(cause *StackTrace) *StackTrace { return captureStackTrace(cause) }
+
+// callNested calls fn, so stack traces captured within fn have common root
+// frames.
+func callNested(fn func()) { fn() }
+
+func TestNewStackTrace_shared(t *testing.T) {
+	callNested(func() {
+		cause := captureNestedStackTrace(nil)
+		nested := captureNestedStackTrace(captureNestedStackTrace(nil))
+
+		var res []*StackTrace
+		for _, c := range []*StackTrace{nil, cause, nested} {
+			res = append(res, captureStackTrace(c))
+		}
+
+		want := res[0]
+		assert.Nil(t, want.tail)
+
+		for i, have := range res[1:] {
+			assert.NotNil(t, have.tail, "%d", i)
+			assert.Len(t, have.own, 2, "%d", i)
+			assert.Equal(t, want.Len(), have.Len(), "%d", i)
+			assert.Equal(t, want.Frames(), have.Frames(), "%d", i)
+			assert.Equal(t, want.String(), have.String(), "%d", i)
+		}
+	})
+}
+
+func TestWrap_sharedStackTrace(t *testing.T) {
+	callNested(func() {
+		cause := func() error { return New("cause") }()
+		err := Wrap(cause, "wrap")
+
+		causeSt, errSt := GetStackTrace(cause), GetStackTrace(err)
+		assert.Same(t, causeSt, errSt.tail)
+		assert.Len(t, errSt.own, 1)
+		assert.Equal(t, errSt.Len()-1, causeSt.sharedRoot(errSt))
+		assert.Equal(t, uint(0), causeSt.Skip)
+		assert.Equal(t, errSt.Frames()[:errSt.Len()-1], causeSt.Frames()[:errSt.Len()-1])
+	})
+}
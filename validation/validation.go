@@ -0,0 +1,188 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package validation contains field-path aware validation errors, which are
+// collected and aggregated into a single error.
+//
+//	var errs validation.Errors
+//	items := errs.Scope("items")
+//	for i, item := range req.Items {
+//		if item.Price < 0 {
+//			items.Index(i).Scope("price").Add(ErrNegativePrice)
+//		}
+//	}
+//	return errs.Err() // items[3].price: price must not be negative
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-pogo/errors"
+	"github.com/go-pogo/errors/errlist"
+)
+
+// DefaultStatusCode is the status code of an [Error] returned by
+// [Errors.Err].
+var DefaultStatusCode = http.StatusUnprocessableEntity
+
+// FieldError is an error which occurred while validating the field at Path.
+type FieldError struct {
+	// Path of the field, e.g. "items[3].price".
+	Path string
+	// Err is the validation error.
+	Err error
+}
+
+// Error returns the field's path followed by the validation error's message.
+func (fe *FieldError) Error() string {
+	if fe.Path == "" {
+		return fe.Err.Error()
+	}
+	return fe.Path + ": " + fe.Err.Error()
+}
+
+// Unwrap returns the validation error.
+func (fe *FieldError) Unwrap() error { return fe.Err }
+
+// GoString prints the error in basic Go syntax.
+func (fe *FieldError) GoString() string {
+	return fmt.Sprintf("validation.FieldError{Path: %q, Err: %#v}", fe.Path, fe.Err)
+}
+
+// Errors collects [FieldError]s. Its zero value is ready to use.
+type Errors struct {
+	list errlist.List
+}
+
+// Scope returns a [Scope] for field name.
+func (e *Errors) Scope(name string) Scope {
+	return Scope{errs: e, path: name}
+}
+
+// Add adds err as a [FieldError] for the field at path. It returns true when
+// err is added, false when err is nil.
+func (e *Errors) Add(path string, err error) bool {
+	if err == nil {
+		return false
+	}
+	return e.list.Append(&FieldError{Path: path, Err: err})
+}
+
+// Len returns the number of collected [FieldError]s.
+func (e *Errors) Len() int { return e.list.Len() }
+
+// Err returns an [Error] containing all collected [FieldError]s, or nil when
+// no errors are collected.
+func (e *Errors) Err() error {
+	if e.list.IsEmpty() {
+		return nil
+	}
+
+	errs := e.list.All()
+	return &Error{
+		errs:       errs,
+		multi:      errors.Join(errs...),
+		statusCode: DefaultStatusCode,
+	}
+}
+
+// Scope is a (nested) field path within [Errors].
+type Scope struct {
+	errs *Errors
+	path string
+}
+
+// Path returns the path of the [Scope], e.g. "items[3].price".
+func (s Scope) Path() string { return s.path }
+
+// Scope returns a nested [Scope] for field name.
+func (s Scope) Scope(name string) Scope {
+	if s.path == "" {
+		return Scope{errs: s.errs, path: name}
+	}
+	return Scope{errs: s.errs, path: s.path + "." + name}
+}
+
+// Index returns a nested [Scope] for index i, e.g. "items[3]".
+func (s Scope) Index(i int) Scope {
+	return Scope{errs: s.errs, path: s.path + "[" + strconv.Itoa(i) + "]"}
+}
+
+// Add adds err as a [FieldError] for the [Scope]'s path. It returns true when
+// err is added, false when err is nil.
+func (s Scope) Add(err error) bool { return s.errs.Add(s.path, err) }
+
+var (
+	_ errors.MultiError        = (*Error)(nil)
+	_ errors.StatusCoderSetter = (*Error)(nil)
+	_ errors.StackTracer       = (*Error)(nil)
+	_ json.Marshaler           = (*Error)(nil)
+)
+
+// Error is an [errors.MultiError] containing one or more [FieldError]s. It has
+// a status code of [DefaultStatusCode], unless changed with
+// [errors.WithStatusCode].
+type Error struct {
+	errs       []error
+	multi      error
+	statusCode int
+}
+
+// Error returns the message of the aggregated [FieldError]s.
+func (e *Error) Error() string { return e.multi.Error() }
+
+// Unwrap returns the [FieldError]s within the [Error].
+func (e *Error) Unwrap() []error { return e.errs }
+
+func (e *Error) SetStatusCode(c int) { e.statusCode = c }
+func (e *Error) StatusCode() int     { return e.statusCode }
+
+func (e *Error) StackTrace() *errors.StackTrace { return errors.GetStackTrace(e.multi) }
+
+// Format formats the aggregated [FieldError]s using [errors.FormatError].
+func (e *Error) Format(s fmt.State, v rune) { errors.FormatError(e.multi, s, v) }
+
+// Lookup returns the [FieldError]s for the field at path.
+func (e *Error) Lookup(path string) []*FieldError {
+	var res []*FieldError
+	for _, err := range e.errs {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if fe := err.(*FieldError); fe.Path == path {
+			res = append(res, fe)
+		}
+	}
+	return res
+}
+
+// MarshalJSON returns the messages of the [FieldError]s as a JSON object,
+// keyed by their path.
+//
+//	{"items[3].price":["price must not be negative"]}
+func (e *Error) MarshalJSON() ([]byte, error) {
+	res := make(map[string][]string, len(e.errs))
+	for _, err := range e.errs {
+		//goland:noinspection GoTypeAssertionOnErrors
+		fe := err.(*FieldError)
+		res[fe.Path] = append(res[fe.Path], fe.Err.Error())
+	}
+	return json.Marshal(res)
+}
+
+// Lookup returns the [FieldError]s for the field at path from the first found
+// [Error] or [FieldError] in err's error chain.
+func Lookup(err error, path string) []*FieldError {
+	var ve *Error
+	if errors.As(err, &ve) {
+		return ve.Lookup(path)
+	}
+
+	var fe *FieldError
+	if errors.As(err, &fe) && fe.Path == path {
+		return []*FieldError{fe}
+	}
+	return nil
+}
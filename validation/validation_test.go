@@ -0,0 +1,94 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	errRequired      errors.Msg = "field is required"
+	errNegativePrice errors.Msg = "price must not be negative"
+)
+
+func TestScope(t *testing.T) {
+	var errs Errors
+	items := errs.Scope("items")
+	assert.Equal(t, "items", items.Path())
+	assert.Equal(t, "items[3]", items.Index(3).Path())
+	assert.Equal(t, "items[3].price", items.Index(3).Scope("price").Path())
+	assert.Equal(t, "name", Scope{errs: &errs}.Scope("name").Path())
+}
+
+func TestErrors_Err(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		var errs Errors
+		assert.Nil(t, errs.Err())
+		assert.False(t, errs.Add("name", nil))
+		assert.Nil(t, errs.Err())
+	})
+
+	var errs Errors
+	assert.True(t, errs.Add("name", errRequired))
+	items := errs.Scope("items")
+	items.Index(1).Scope("price").Add(errNegativePrice)
+	items.Index(3).Scope("price").Add(errNegativePrice)
+	items.Index(3).Scope("price").Add(errors.New("price must be a round number"))
+	assert.Equal(t, 4, errs.Len())
+
+	err := errs.Err()
+	assert.ErrorIs(t, err, errRequired)
+	assert.ErrorIs(t, err, errNegativePrice)
+	assert.Equal(t, http.StatusUnprocessableEntity, errors.GetStatusCode(err))
+	assert.Equal(t, "multiple errors occurred:\n"+
+		"[1/4] name: field is required;\n"+
+		"[2/4] items[1].price: price must not be negative;\n"+
+		"[3/4] items[3].price: price must not be negative;\n"+
+		"[4/4] items[3].price: price must be a round number",
+		err.Error(),
+	)
+	assert.Equal(t, err.Error(), fmt.Sprintf("%v", err))
+
+	t.Run("status code", func(t *testing.T) {
+		err := errs.Err()
+		assert.Same(t, err, errors.WithStatusCode(err, http.StatusBadRequest))
+		assert.Equal(t, http.StatusBadRequest, errors.GetStatusCode(err))
+	})
+	t.Run("lookup", func(t *testing.T) {
+		have := Lookup(err, "items[3].price")
+		assert.Len(t, have, 2)
+		assert.ErrorIs(t, have[0], errNegativePrice)
+
+		assert.Len(t, Lookup(errors.Wrap(err, "wrapped"), "name"), 1)
+		assert.Empty(t, Lookup(err, "items"))
+	})
+	t.Run("json", func(t *testing.T) {
+		have, jsonErr := json.Marshal(err)
+		assert.NoError(t, jsonErr)
+		assert.JSONEq(t, `{
+			"name": ["field is required"],
+			"items[1].price": ["price must not be negative"],
+			"items[3].price": ["price must not be negative", "price must be a round number"]
+		}`, string(have))
+	})
+}
+
+func TestLookup(t *testing.T) {
+	fe := &FieldError{Path: "name", Err: errRequired}
+	assert.Equal(t, []*FieldError{fe}, Lookup(errors.WithStack(fe), "name"))
+	assert.Nil(t, Lookup(fe, "other"))
+	assert.Nil(t, Lookup(nil, "name"))
+}
+
+func TestFieldError(t *testing.T) {
+	assert.Equal(t, "name: field is required", (&FieldError{Path: "name", Err: errRequired}).Error())
+	assert.Equal(t, "field is required", (&FieldError{Err: errRequired}).Error())
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+// walkChain calls yield for err and each error in its chain, as returned by
+// [Unwrap], until yield returns false.
+func walkChain(err error, yield func(error) bool) bool {
+	for err != nil {
+		if !yield(err) {
+			return false
+		}
+		err = Unwrap(err)
+	}
+	return true
+}
+
+// walkTree calls yield for err and all errors it wraps, depth-first, until
+// yield returns false. Errors of a [MultiError] are walked in order. When
+// leavesOnly is true, yield is only called for errors that do not wrap any
+// other error.
+func walkTree(err error, leavesOnly bool, yield func(error) bool) bool {
+	for err != nil {
+		//goland:noinspection GoTypeAssertionOnErrors
+		if m, ok := err.(MultiError); ok {
+			errs := m.Unwrap()
+			if !leavesOnly || len(errs) == 0 {
+				if !yield(err) {
+					return false
+				}
+			}
+			for _, e := range errs {
+				if !walkTree(e, leavesOnly, yield) {
+					return false
+				}
+			}
+			return true
+		}
+
+		next := Unwrap(err)
+		if !leavesOnly || next == nil {
+			if !yield(err) {
+				return false
+			}
+		}
+		err = next
+	}
+	return true
+}
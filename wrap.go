@@ -6,7 +6,6 @@ package errors
 
 import (
 	stderrors "errors"
-	"fmt"
 	"reflect"
 
 	"golang.org/x/xerrors"
@@ -35,24 +34,31 @@ func Wrap(cause error, msg interface{}) error {
 		return cause
 	}
 
-	var parent error
+	err := newWrapErr(toMsg("errors.Wrap", msg), cause, 1)
+	runHooks(err, 1)
+	return err
+}
+
+// toMsg converts msg, which can be either a string or [Msg], to a [Msg]. It
+// panics with a message mentioning fn when msg is of an unsupported type.
+func toMsg(fn string, msg interface{}) Msg {
 	switch v := msg.(type) {
 
 	case string:
-		parent = Msg(v)
+		return Msg(v)
 	case *string:
-		parent = Msg(*v)
+		return Msg(*v)
 
 	case Msg:
-		parent = v
+		return v
 	case *Msg:
-		parent = *v
+		return *v
 
+	case nil:
+		panic(unsupportedType(fn, "nil"))
 	default:
-		panic(unsupportedType("errors.Wrap", reflect.TypeOf(v).String()))
+		panic(unsupportedType(fn, reflect.TypeOf(v).String()))
 	}
-
-	return withCause(newCommonErr(parent, true, 1), cause)
 }
 
 // Wrapf formats an error message according to a format specifier and provided
@@ -63,7 +69,9 @@ func Wrapf(cause error, format string, args ...interface{}) error {
 	if cause == nil {
 		return nil
 	}
-	return withCause(newCommonErr(fmt.Errorf(format, args...), true, 1), cause)
+	err := newWrapErr(newFormatMsg(format, args), cause, 1)
+	runHooks(err, 1)
+	return err
 }
 
 // Opaque is an alias of [xerrors.Opaque]. It returns an error with the same